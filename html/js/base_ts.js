@@ -38,10 +38,10 @@ menuItems.push(new MainMenuItem("logout", "{{.mainMenu.item.logout}}", "logout.p
 // Kategorien für die Einstellungen
 var settingsCategory = new Array();
 settingsCategory.push(new SettingsCategoryItem("{{.settings.category.general}}", "ThreadfinAutoUpdate,ssdp,tuner,epgSource,epgCategories,epgCategoriesColors,dummy,dummyChannel,ignoreFilters,api"));
-settingsCategory.push(new SettingsCategoryItem("{{.settings.category.files}}", "update,files.update,temp.path,cache.images,bindIpAddress,httpThreadfinDomain,forceHttps,excludeStreamHttps,httpsPort,httpsThreadfinDomain,xepg.replace.missing.images,xepg.replace.channel.title,enableNonAscii"));
-settingsCategory.push(new SettingsCategoryItem("{{.settings.category.streaming}}", "udpxy,buffer.size.kb,buffer.timeout,user.agent,ffmpeg.path,ffmpeg.options,ffmpeg.forceHttp,vlc.path,vlc.options"));
+settingsCategory.push(new SettingsCategoryItem("{{.settings.category.files}}", "update,files.update,temp.path,cache.images,bindIpAddress,httpThreadfinDomain,forceHttps,excludeStreamHttps,httpsPort,httpsThreadfinDomain,xepg.replace.missing.images,xepg.replace.channel.title,channel.groups,channel.groups.rules,m3u.attributes.allow,m3u.attributes.deny,enableNonAscii"));
+settingsCategory.push(new SettingsCategoryItem("{{.settings.category.streaming}}", "udpxy,udp.interface,buffer.size.kb,storeBufferInRAM,buffer.stream.max.mb,buffer.stall.timeout,buffer.timeout,user.agent,ffmpeg.path,ffmpeg.options,ffmpeg.forceHttp,vlc.path,vlc.options,command.path,command.options,buffer.profiles,tuner.pools,stream.priorities,slates,probe.interval,probe.concurrency,probe.failures,probe.dead.action"));
 settingsCategory.push(new SettingsCategoryItem("{{.settings.category.backup}}", "backup.path,backup.keep"));
-settingsCategory.push(new SettingsCategoryItem("{{.settings.category.authentication}}", "authentication.web,authentication.pms,authentication.m3u,authentication.xml,authentication.api"));
+settingsCategory.push(new SettingsCategoryItem("{{.settings.category.authentication}}", "authentication.web,authentication.pms,authentication.m3u,authentication.xml,authentication.api,authentication.xtream"));
 function showPopUpElement(elm) {
     showElement(elm, true);
     // setTimeout(function () {
@@ -129,6 +129,16 @@ function getObjKeys(obj) {
 function getOwnObjProps(object) {
     return object ? Object.getOwnPropertyNames(object) : [];
 }
+function getBufferProfileNames() {
+    var names = getOwnObjProps(SERVER["settings"]["buffer.profiles"]).sort();
+    names.unshift("-");
+    return names;
+}
+function getTunerPoolNames() {
+    var names = getOwnObjProps(SERVER["settings"]["tuner.pools"]).sort();
+    names.unshift("-");
+    return names;
+}
 function getAllSelectedChannels() {
     var channels = new Array();
     if (BULK_EDIT == false) {
@@ -538,6 +548,29 @@ function probeChannel(url) {
     server.request(data);
     return;
 }
+function previewChannel(playlistID, url) {
+    var data = new Object();
+    var cmd = "previewChannel";
+    data["previewPlaylistID"] = playlistID;
+    data["previewURL"] = url;
+    var server = new Server(cmd);
+    server.request(data);
+    return;
+}
+function showChannelPreview(url) {
+    var td = document.getElementById("channelPreview");
+    if (!td) {
+        return;
+    }
+    // Browsers without HLS support: the playlist can be opened in a player
+    td.innerHTML = "<video controls autoplay muted playsinline style='width: 100%'></video><p><a href='" + url + "' target='_blank'>" + url + "</a></p>";
+    td.getElementsByTagName("video")[0].src = url;
+    // The HLS output is stopped when the popup is closed
+    document.getElementById("popup").addEventListener("hidden.bs.modal", function () {
+        td.innerHTML = "";
+    }, { once: true });
+    return;
+}
 function checkUndo(key) {
     switch (key) {
         case "epgMapping":
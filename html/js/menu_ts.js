@@ -45,7 +45,7 @@ class MainMenuItem extends MainMenu {
                 this.tableHeader = ["{{.filter.table.startingNumber}}", "{{.filter.table.name}}", "{{.filter.table.type}}", "{{.filter.table.filter}}"];
                 break;
             case "users":
-                this.tableHeader = ["{{.users.table.username}}", "{{.users.table.password}}", "{{.users.table.web}}", "{{.users.table.pms}}", "{{.users.table.m3u}}", "{{.users.table.xml}}", "{{.users.table.api}}"];
+                this.tableHeader = ["{{.users.table.username}}", "{{.users.table.password}}", "{{.users.table.web}}", "{{.users.table.pms}}", "{{.users.table.m3u}}", "{{.users.table.xml}}", "{{.users.table.api}}", "{{.users.table.xtream}}"];
                 break;
             case "mapping":
                 this.tableHeader = ["BULK", "{{.mapping.table.chNo}}", "{{.mapping.table.logo}}", "{{.mapping.table.channelName}}", "{{.mapping.table.playlist}}", "{{.mapping.table.groupTitle}}", "{{.mapping.table.xmltvFile}}", "{{.mapping.table.xmltvID}}"];
@@ -323,6 +323,16 @@ class Content {
                             cell.value = "-";
                         }
                         tr.appendChild(cell.createCell());
+                        var cell = new Cell();
+                        cell.child = true;
+                        cell.childType = "P";
+                        if (data[key]["data"]["authentication.xtream"] == true) {
+                            cell.value = "✓";
+                        }
+                        else {
+                            cell.value = "-";
+                        }
+                        tr.appendChild(cell.createCell());
                         rows.push(tr);
                     });
                 });
@@ -377,6 +387,15 @@ class Content {
                             }
                         }
                         cell.value = data[key]["x-name"];
+                        // Die letzten Hintergrund-Tests haben den Stream als tot markiert
+                        var probes = SERVER["probes"] || {};
+                        if (probes[key] != undefined && probes[key]["dead"] == true) {
+                            cell.value = "<span class='badge bg-danger' title='" + probes[key]["error"] + "'>{{.mapping.dead}}</span> " + cell.value;
+                        }
+                        // Versteckter Kanal einer Kanalgruppe, der Stream ist ein Backup des Hauptkanals
+                        if (data[key]["x-channel-group-backup"] == true && data[data[key]["x-channel-group"]] != undefined) {
+                            cell.value = "<span class='badge bg-secondary' title='" + data[data[key]["x-channel-group"]]["x-name"] + "'>{{.mapping.groupBackup}}</span> " + cell.value;
+                        }
                         var td = cell.createCell();
                         td.setAttribute('onclick', 'javascript: openPopUp("mapping", this)');
                         td.id = key;
@@ -500,6 +519,15 @@ class Content {
                             }
                         }
                         cell.value = data[key]["x-name"];
+                        // Die letzten Hintergrund-Tests haben den Stream als tot markiert
+                        var probes = SERVER["probes"] || {};
+                        if (probes[key] != undefined && probes[key]["dead"] == true) {
+                            cell.value = "<span class='badge bg-danger' title='" + probes[key]["error"] + "'>{{.mapping.dead}}</span> " + cell.value;
+                        }
+                        // Versteckter Kanal einer Kanalgruppe, der Stream ist ein Backup des Hauptkanals
+                        if (data[key]["x-channel-group-backup"] == true && data[data[key]["x-channel-group"]] != undefined) {
+                            cell.value = "<span class='badge bg-secondary' title='" + data[data[key]["x-channel-group"]]["x-name"] + "'>{{.mapping.groupBackup}}</span> " + cell.value;
+                        }
                         var td = cell.createCell();
                         td.setAttribute('onclick', 'javascript: openPopUp("mapping", this)');
                         td.id = key;
@@ -1116,8 +1144,44 @@ function openPopUp(dataType, element) {
             var input = content.createInput("text", dbKey, data[dbKey]);
             input.setAttribute("placeholder", "{{.playlist.fileM3U.placeholder}}");
             content.appendRow("{{.playlist.fileM3U.title}}", input);
-            var text = ["-", "FFmpeg", "VLC"];
-            var values = ["-", "ffmpeg", "vlc"];
+            // Xtream Codes: URL des Panels und Zugangsdaten (player_api.php), Stalker Portal: URL des Portals und MAC Adresse, Enigma2: Adresse des Receivers (OpenWebif)
+            var dbKey = "source.type";
+            var text = ["{{.playlist.sourceType.file}}", "Xtream Codes", "Stalker Portal", "Enigma2 (OpenWebif)"];
+            var values = ["-", "xtream", "stalker", "enigma2"];
+            var select = content.createSelect(text, values, data[dbKey], dbKey);
+            content.appendRow("{{.playlist.sourceType.title}}", select);
+            content.description("{{.playlist.sourceType.description}}");
+            var dbKey = "xtream.username";
+            var input = content.createInput("text", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.playlist.xtreamUsername.placeholder}}");
+            content.appendRow("{{.playlist.xtreamUsername.title}}", input);
+            var dbKey = "xtream.password";
+            var input = content.createInput("password", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.playlist.xtreamPassword.placeholder}}");
+            content.appendRow("{{.playlist.xtreamPassword.title}}", input);
+            var dbKey = "xtream.output";
+            var text = ["MPEG-TS (.ts)", "HLS (.m3u8)"];
+            var values = ["ts", "m3u8"];
+            var select = content.createSelect(text, values, data[dbKey], dbKey);
+            content.appendRow("{{.playlist.xtreamOutput.title}}", select);
+            content.description("{{.playlist.xtreamOutput.description}}");
+            var dbKey = "stalker.mac";
+            var input = content.createInput("text", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.playlist.stalkerMac.placeholder}}");
+            content.appendRow("{{.playlist.stalkerMac.title}}", input);
+            content.description("{{.playlist.stalkerMac.description}}");
+            var dbKey = "enigma2.bouquets";
+            var input = content.createInput("text", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.playlist.enigma2Bouquets.placeholder}}");
+            content.appendRow("{{.playlist.enigma2Bouquets.title}}", input);
+            content.description("{{.playlist.enigma2Bouquets.description}}");
+            var dbKey = "enigma2.port";
+            var input = content.createInput("text", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.playlist.enigma2Port.placeholder}}");
+            content.appendRow("{{.playlist.enigma2Port.title}}", input);
+            content.description("{{.playlist.enigma2Port.description}}");
+            var text = ["-", "Threadfin", "FFmpeg", "VLC", "Command"];
+            var values = ["-", "threadfin", "ffmpeg", "vlc", "command"];
             var selected = SERVER["settings"]["buffer"];
             if (data["buffer"] != undefined) {
                 selected = data["buffer"];
@@ -1125,6 +1189,12 @@ function openPopUp(dataType, element) {
             var select = content.createSelect(text, values, selected, "buffer");
             select.setAttribute("id", "buffer");
             content.appendRow("{{.playlist.buffer.title}}", select);
+            // Buffer profile (FFmpeg / VLC)
+            var dbKey = "buffer.profile";
+            var profiles = getBufferProfileNames();
+            var select = content.createSelect(profiles, profiles, data[dbKey], dbKey);
+            content.appendRow("{{.playlist.bufferProfile.title}}", select);
+            content.description("{{.playlist.bufferProfile.description}}");
             // Tuner
             var text = new Array();
             var values = new Array();
@@ -1137,6 +1207,24 @@ function openPopUp(dataType, element) {
             select.setAttribute("onfocus", "javascript: return;");
             content.appendRow("{{.playlist.tuner.title}}", select);
             content.description("{{.playlist.tuner.description}}");
+            // Tuner pool (providers with the same account)
+            var dbKey = "tuner.pool";
+            var pools = getTunerPoolNames();
+            var select = content.createSelect(pools, pools, data[dbKey], dbKey);
+            content.appendRow("{{.playlist.tunerPool.title}}", select);
+            content.description("{{.playlist.tunerPool.description}}");
+            // Priority of the provider (channel groups)
+            var dbKey = "priority";
+            var text = ["0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10"];
+            var select = content.createSelect(text, text, data[dbKey], dbKey);
+            content.appendRow("{{.playlist.priority.title}}", select);
+            content.description("{{.playlist.priority.description}}");
+            // Accounts (several credentials of the provider)
+            var dbKey = "accounts";
+            var input = content.createInput("text", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.playlist.accounts.placeholder}}");
+            content.appendRow("{{.playlist.accounts.title}}", input);
+            content.description("{{.playlist.accounts.description}}");
             var dbKey = "http_proxy.ip";
             var input = content.createInput("text", dbKey, data[dbKey]);
             input.setAttribute("placeholder", "{{.playlist.http_proxy_ip.placeholder}}");
@@ -1203,8 +1291,8 @@ function openPopUp(dataType, element) {
             var input = content.createInput("text", dbKey, data[dbKey]);
             input.setAttribute("placeholder", "{{.playlist.fileHDHR.placeholder}}");
             content.appendRow("{{.playlist.fileHDHR.title}}", input);
-            var text = ["-", "FFmpeg", "VLC"];
-            var values = ["-", "ffmpeg", "vlc"];
+            var text = ["-", "Threadfin", "FFmpeg", "VLC", "Command"];
+            var values = ["-", "threadfin", "ffmpeg", "vlc", "command"];
             var selected = SERVER["settings"]["buffer"];
             if (data["buffer"] != undefined) {
                 selected = data["buffer"];
@@ -1212,6 +1300,12 @@ function openPopUp(dataType, element) {
             var select = content.createSelect(text, values, selected, "buffer");
             select.setAttribute("id", "buffer");
             content.appendRow("{{.playlist.buffer.title}}", select);
+            // Buffer profile (FFmpeg / VLC)
+            var dbKey = "buffer.profile";
+            var profiles = getBufferProfileNames();
+            var select = content.createSelect(profiles, profiles, data[dbKey], dbKey);
+            content.appendRow("{{.playlist.bufferProfile.title}}", select);
+            content.description("{{.playlist.bufferProfile.description}}");
             // Tuner
             var text = new Array();
             var values = new Array();
@@ -1224,6 +1318,24 @@ function openPopUp(dataType, element) {
             select.setAttribute("onfocus", "javascript: return;");
             content.appendRow("{{.playlist.tuner.title}}", select);
             content.description("{{.playlist.tuner.description}}");
+            // Tuner pool (providers with the same account)
+            var dbKey = "tuner.pool";
+            var pools = getTunerPoolNames();
+            var select = content.createSelect(pools, pools, data[dbKey], dbKey);
+            content.appendRow("{{.playlist.tunerPool.title}}", select);
+            content.description("{{.playlist.tunerPool.description}}");
+            // Priority of the provider (channel groups)
+            var dbKey = "priority";
+            var text = ["0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10"];
+            var select = content.createSelect(text, text, data[dbKey], dbKey);
+            content.appendRow("{{.playlist.priority.title}}", select);
+            content.description("{{.playlist.priority.description}}");
+            // Accounts (several credentials of the provider)
+            var dbKey = "accounts";
+            var input = content.createInput("text", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.playlist.accounts.placeholder}}");
+            content.appendRow("{{.playlist.accounts.title}}", input);
+            content.description("{{.playlist.accounts.description}}");
             var dbKey = "http_proxy.ip";
             var input = content.createInput("text", dbKey, data[dbKey]);
             input.setAttribute("placeholder", "{{.playlist.http_proxy_ip.placeholder}}");
@@ -1417,6 +1529,26 @@ function openPopUp(dataType, element) {
             var input = content.createInput("text", dbKey, data[dbKey]);
             input.setAttribute("placeholder", "{{.xmltv.fileXMLTV.placeholder}}");
             content.appendRow("{{.xmltv.fileXMLTV.title}}", input);
+            // Xtream Codes: URL des Panels und Zugangsdaten (player_api.php), Enigma2: Adresse des Receivers (OpenWebif)
+            var dbKey = "source.type";
+            var text = ["{{.xmltv.sourceType.file}}", "Xtream Codes", "Enigma2 (OpenWebif)"];
+            var values = ["-", "xtream", "enigma2"];
+            var select = content.createSelect(text, values, data[dbKey], dbKey);
+            content.appendRow("{{.xmltv.sourceType.title}}", select);
+            content.description("{{.xmltv.sourceType.description}}");
+            var dbKey = "xtream.username";
+            var input = content.createInput("text", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.xmltv.xtreamUsername.placeholder}}");
+            content.appendRow("{{.xmltv.xtreamUsername.title}}", input);
+            var dbKey = "xtream.password";
+            var input = content.createInput("password", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.xmltv.xtreamPassword.placeholder}}");
+            content.appendRow("{{.xmltv.xtreamPassword.title}}", input);
+            var dbKey = "enigma2.bouquets";
+            var input = content.createInput("text", dbKey, data[dbKey]);
+            input.setAttribute("placeholder", "{{.xmltv.enigma2Bouquets.placeholder}}");
+            content.appendRow("{{.xmltv.enigma2Bouquets.title}}", input);
+            content.description("{{.xmltv.enigma2Bouquets.description}}");
             var dbKey = "http_proxy.ip";
             var input = content.createInput("text", dbKey, data[dbKey]);
             input.setAttribute("placeholder", "{{.xmltv.http_proxy_ip.placeholder}}");
@@ -1496,6 +1628,11 @@ function openPopUp(dataType, element) {
             var input = content.createCheckbox(dbKey);
             input.checked = data[dbKey];
             content.appendRow("{{.users.api.title}}", input);
+            // Berechtigung Xtream Codes API
+            var dbKey = "authentication.xtream";
+            var input = content.createCheckbox(dbKey);
+            input.checked = data[dbKey];
+            content.appendRow("{{.users.xtream.title}}", input);
             // Interaktion
             content.createInteraction();
             // Löschen
@@ -1631,45 +1768,34 @@ function openPopUp(dataType, element) {
                 input.setAttribute("id", "ppv-extra");
                 content.appendRow("{{.mapping.ppvextra.title}}", input);
             }
-            var dbKey = "x-backup-channel-1";
-            var xmltv = new XMLTVFile();
-            const backup1XmlTvId = data[dbKey];
-            const [xmlTvBackup1IdContainer, xmlTvBackup1IdInput, xmlTvBackup1IdDatalist] = xmltv.newM3uPicker(xmlFile, backup1XmlTvId);
-            xmlTvBackup1IdContainer.setAttribute('id', 'm3u-id-picker-container-1');
-            xmlTvBackup1IdInput.setAttribute('list', 'm3u-id-picker-datalist');
-            xmlTvBackup1IdInput.setAttribute('name', dbKey); // Should stay x-mapping as it will be used in donePopupData to make a server request
-            xmlTvBackup1IdInput.setAttribute("id", "backup-channel-1");
-            xmlTvBackup1IdInput.setAttribute('onchange', `javascript: this.className = 'changed'; checkXmltvChannel('${id}', this, '${xmlFile}');`);
-            xmlTvBackup1IdDatalist.setAttribute('id', 'm3u-id-picker-datalist');
-            // sortSelect(xmlTvIdDatalist); // TODO: Better sort before adding
-            content.appendRow('{{.mapping.backupChannel1.title}}', xmlTvBackup1IdContainer);
-            var dbKey = "x-backup-channel-2";
-            var xmltv = new XMLTVFile();
-            const backup2XmlTvId = data[dbKey];
-            const [xmlTvBackup2IdContainer, xmlTvBackup2IdInput, xmlTvBackup2IdDatalist] = xmltv.newM3uPicker(xmlFile, backup2XmlTvId);
-            xmlTvBackup2IdContainer.setAttribute('id', 'xmltv-id-picker-container-2');
-            xmlTvBackup2IdInput.setAttribute('list', 'm3u-id-picker-datalist');
-            xmlTvBackup2IdInput.setAttribute('name', dbKey); // Should stay x-mapping as it will be used in donePopupData to make a server request
-            xmlTvBackup2IdInput.setAttribute("id", "backup-channel-2");
-            xmlTvBackup2IdInput.setAttribute('onchange', `javascript: this.className = 'changed'; checkXmltvChannel('${id}', this, '${xmlFile}');`);
-            xmlTvBackup2IdDatalist.setAttribute('id', 'm3u-id-picker-datalist');
-            content.appendRow("{{.mapping.backupChannel2.title}}", xmlTvBackup2IdContainer);
-            var dbKey = "x-backup-channel-3";
-            var xmltv = new XMLTVFile();
-            const backup3XmlTvId = data[dbKey];
-            const [xmlTvBackup3IdContainer, xmlTvBackup3IdInput, xmlTvBackup3IdDatalist] = xmltv.newM3uPicker(xmlFile, backup3XmlTvId);
-            xmlTvBackup3IdContainer.setAttribute('id', 'xmltv-id-picker-container-3');
-            xmlTvBackup3IdInput.setAttribute('list', 'm3u-id-picker-datalist');
-            xmlTvBackup3IdInput.setAttribute('name', dbKey); // Should stay x-mapping as it will be used in donePopupData to make a server request
-            xmlTvBackup3IdInput.setAttribute("id", "backup-channel-3");
-            xmlTvBackup3IdInput.setAttribute('onchange', `javascript: this.className = 'changed'; checkXmltvChannel('${id}', this, '${xmlFile}');`);
-            xmlTvBackup3IdDatalist.setAttribute('id', 'm3u-id-picker-datalist');
-            content.appendRow("{{.mapping.backupChannel3.title}}", xmlTvBackup3IdContainer);
+            // Backup channels (ordered list, XEPG ID, tvg-id or channel name)
+            var dbKey = "x-backup-channels";
+            var backupChannels = (data[dbKey] || []).slice();
+            backupChannels.push("");
+            var backupContainer = document.createElement("DIV");
+            backupContainer.setAttribute("id", "backup-channels");
+            backupChannels.forEach(backupChannel => {
+                backupContainer.appendChild(newBackupChannelPicker(xmlFile, backupChannel));
+            });
+            var input = content.createInput("button", "addBackupChannel", "+");
+            input.setAttribute("onclick", `javascript: document.getElementById('backup-channels').appendChild(newBackupChannelPicker('${xmlFile}', ''))`);
+            backupContainer.appendChild(input);
+            content.appendRow("{{.mapping.backupChannels.title}}", backupContainer);
+            // Buffer profile (FFmpeg / VLC), overrides the profile of the provider
+            var dbKey = "x-buffer-profile";
+            var profiles = getBufferProfileNames();
+            var select = content.createSelect(profiles, profiles, data[dbKey], dbKey);
+            select.setAttribute("onchange", "javascript: this.className = 'changed'");
+            content.appendRow("{{.mapping.bufferProfile.title}}", select);
             // Interaktion
             content.createInteraction();
             var input = content.createInput("button", "cancel", "{{.button.probeChannel}}");
             input.setAttribute("onclick", 'javascript: probeChannel("' + data["url"] + '");');
             content.addInteraction(input);
+            // Vorschau (HLS)
+            var input = content.createInput("button", "cancel", "{{.button.previewChannel}}");
+            input.setAttribute("onclick", 'javascript: previewChannel("' + data["_file.m3u.id"] + '", "' + data["url"] + '");');
+            content.addInteraction(input);
             // Logo hochladen
             var input = content.createInput("button", "cancel", "{{.button.uploadLogo}}");
             input.setAttribute("onclick", 'javascript: uploadLogo();');
@@ -1691,9 +1817,31 @@ function openPopUp(dataType, element) {
             cell.child = true;
             cell.childType = "P";
             cell.value = "<span></span>";
+            // Ergebnis des letzten Hintergrund-Tests
+            var probe = (SERVER["probes"] || {})[id];
+            if (probe != undefined) {
+                cell.value = "<p>{{.mapping.probeDetails.last}}: <span class='text-primary'>" + new Date(probe["time"]).toLocaleString() + "</span></p>";
+                if (probe["reachable"] == true) {
+                    cell.value += "<p>Resolution: <span class='text-primary'>" + probe["resolution"] + "</span></p><p>Codecs: <span class='text-primary'>" + [probe["videoCodec"], probe["audioCodec"]].filter(Boolean).join(", ") + "</span></p><p>TTFB: <span class='text-primary'>" + probe["ttfb"] + " ms</span></p>";
+                }
+                else {
+                    cell.value += "<p class='text-danger'>" + probe["error"] + " (" + probe["failures"] + "x)</p>";
+                }
+            }
+            else if (data["x-resolution"] != undefined) {
+                // Gespeicherte Stream-Metadaten (Kanal testen)
+                cell.value = "<p>Resolution: <span class='text-primary'>" + data["x-resolution"] + "</span></p><p>Frame Rate: <span class='text-primary'>" + data["x-frame-rate"] + " FPS</span></p><p>Codecs: <span class='text-primary'>" + [data["x-video-codec"], data["x-audio-codec"]].filter(Boolean).join(", ") + "</span></p>";
+            }
             var td = cell.createCell();
             td.id = "probeDetails";
             content.appendRow("{{.mapping.probeDetails.title}}", td);
+            var cell = new Cell();
+            cell.child = true;
+            cell.childType = "P";
+            cell.value = "<span></span>";
+            var td = cell.createCell();
+            td.id = "channelPreview";
+            content.appendRow("{{.mapping.preview.title}}", td);
             break;
         default:
             break;
@@ -1950,6 +2098,26 @@ function checkPPV(title, element) {
         this.table.appendChild(tr);
     }
 }
+function newBackupChannelPicker(xmlFile, backupChannel) {
+    var xmltv = new XMLTVFile();
+    const [container, input, datalist] = xmltv.newM3uPicker(xmlFile, backupChannel);
+    input.setAttribute('list', 'm3u-id-picker-datalist');
+    input.setAttribute('name', 'x-backup-channels');
+    input.setAttribute('onchange', "javascript: this.className = 'changed'");
+    datalist.setAttribute('id', 'm3u-id-picker-datalist');
+    return container;
+}
+function getBackupChannels(div) {
+    var backupChannels = new Array();
+    var inputs = div.querySelectorAll("input[name='x-backup-channels']");
+    for (let i = 0; i < inputs.length; i++) {
+        var value = inputs[i].value.trim();
+        if (value.length > 0 && value != "-") {
+            backupChannels.push(value);
+        }
+    }
+    return backupChannels;
+}
 function checkXmltvChannel(id, element, xmlFile) {
     var value = element.value;
     var bool;
@@ -2166,6 +2334,10 @@ function donePopupData(dataType, idsStr) {
                         case "text":
                             name = inputs[i].name;
                             value = inputs[i].value;
+                            // All backup channels are saved as a list, in the order of the inputs
+                            if (name == "x-backup-channels") {
+                                value = getBackupChannels(div);
+                            }
                             input[name] = value;
                             break;
                     }
@@ -57,10 +57,13 @@ class Server {
             if (response.hasOwnProperty("probeInfo")) {
                 if (document.getElementById("probeDetails")) {
                     if (response["probeInfo"]["resolution"] !== undefined) {
-                        document.getElementById("probeDetails").innerHTML = "<p>Resolution: <span class='text-primary'>" + response["probeInfo"]["resolution"] + "</span></p><p>Frame Rate: <span class='text-primary'>" + response["probeInfo"]["frameRate"] + " FPS</span></p><p>Audio: <span class='text-primary'>" + response["probeInfo"]["audioChannel"] + "</span></p>";
+                        document.getElementById("probeDetails").innerHTML = "<p>Resolution: <span class='text-primary'>" + response["probeInfo"]["resolution"] + "</span></p><p>Frame Rate: <span class='text-primary'>" + response["probeInfo"]["frameRate"] + " FPS</span></p><p>Audio: <span class='text-primary'>" + response["probeInfo"]["audioChannel"] + "</span></p><p>Codecs: <span class='text-primary'>" + [response["probeInfo"]["videoCodec"], response["probeInfo"]["audioCodec"]].filter(Boolean).join(", ") + "</span></p>";
                     }
                 }
             }
+            if (response.hasOwnProperty("previewURL")) {
+                showChannelPreview(response["previewURL"]);
+            }
             if (response.hasOwnProperty("logoURL")) {
                 var div = document.getElementById("channel-icon");
                 div.value = response["logoURL"];
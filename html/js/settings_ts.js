@@ -116,6 +116,83 @@ class SettingsCategory {
                 setting.appendChild(tdLeft);
                 setting.appendChild(tdRight);
                 break;
+            case "buffer.profiles":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.bufferProfiles.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var container = document.createElement("DIV");
+                container.setAttribute("id", "buffer-profiles");
+                getOwnObjProps(data).sort().forEach(name => {
+                    container.appendChild(newBufferProfile(data[name]));
+                });
+                container.appendChild(newBufferProfile(undefined));
+                tdRight.appendChild(container);
+                var input = content.createInput("button", "addBufferProfile", "+");
+                input.setAttribute("onclick", "javascript: document.getElementById('buffer-profiles').appendChild(newBufferProfile(undefined))");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "slates":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.slates.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var slates = ["tuner-limit", "offline", "provider-error", "unauthorized"];
+                var slateNames = "{{.settings.slates.names}}".split(",");
+                var custom = SERVER["slates"] || {};
+                slates.forEach(name => {
+                    var div = document.createElement("DIV");
+                    div.className = "buffer-profile";
+                    var label = document.createElement("SPAN");
+                    label.innerHTML = slateNames[slates.indexOf(name)] + ": " + (custom[name] == true ? "{{.settings.slates.custom}}" : "{{.settings.slates.default}}") + " ";
+                    div.appendChild(label);
+                    var input = content.createInput("button", "uploadSlate", "{{.settings.slates.upload}}");
+                    input.setAttribute("onclick", "javascript: uploadSlate('" + name + "')");
+                    div.appendChild(input);
+                    if (custom[name] == true) {
+                        var input = content.createInput("button", "removeSlate", "{{.settings.slates.remove}}");
+                        input.setAttribute("onclick", "javascript: removeSlate('" + name + "')");
+                        div.appendChild(input);
+                    }
+                    tdRight.appendChild(div);
+                });
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "tuner.pools":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.tunerPools.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var container = document.createElement("DIV");
+                container.setAttribute("id", "tuner-pools");
+                getOwnObjProps(data).sort().forEach(name => {
+                    container.appendChild(newTunerPool(data[name]));
+                });
+                container.appendChild(newTunerPool(undefined));
+                tdRight.appendChild(container);
+                var input = content.createInput("button", "addTunerPool", "+");
+                input.setAttribute("onclick", "javascript: document.getElementById('tuner-pools').appendChild(newTunerPool(undefined))");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "stream.priorities":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.streamPriorities.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var container = document.createElement("DIV");
+                container.setAttribute("id", "stream-priorities");
+                (data || []).forEach(rule => {
+                    container.appendChild(newStreamPriority(rule));
+                });
+                container.appendChild(newStreamPriority(undefined));
+                tdRight.appendChild(container);
+                var input = content.createInput("button", "addStreamPriority", "+");
+                input.setAttribute("onclick", "javascript: document.getElementById('stream-priorities').appendChild(newStreamPriority(undefined))");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
             case "vlc.options":
                 var tdLeft = document.createElement("TD");
                 tdLeft.innerHTML = "{{.settings.vlcOptions.title}}" + ":";
@@ -127,6 +204,28 @@ class SettingsCategory {
                 setting.appendChild(tdLeft);
                 setting.appendChild(tdRight);
                 break;
+            case "command.path":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.commandPath.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var input = content.createInput("text", "command.path", data);
+                input.setAttribute("placeholder", "{{.settings.commandPath.placeholder}}");
+                input.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "command.options":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.commandOptions.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var input = content.createInput("text", "command.options", data);
+                input.setAttribute("placeholder", "{{.settings.commandOptions.placeholder}}");
+                input.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
             // Checkboxen
             case "authentication.web":
                 var tdLeft = document.createElement("TD");
@@ -183,6 +282,17 @@ class SettingsCategory {
                 setting.appendChild(tdLeft);
                 setting.appendChild(tdRight);
                 break;
+            case "authentication.xtream":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.authenticationXtream.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var input = content.createCheckbox(settingsKey);
+                input.checked = data;
+                input.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
             case "files.update":
                 var tdLeft = document.createElement("TD");
                 tdLeft.innerHTML = "{{.settings.filesUpdate.title}}" + ":";
@@ -227,6 +337,51 @@ class SettingsCategory {
                 setting.appendChild(tdLeft);
                 setting.appendChild(tdRight);
                 break;
+            case "channel.groups":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.channelGroups.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var input = content.createCheckbox(settingsKey);
+                input.checked = data;
+                input.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "channel.groups.rules":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.channelGroupRules.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var text = ["{{.settings.channelGroupRules.resolution}} > {{.settings.channelGroupRules.priority}} > {{.settings.channelGroupRules.availability}}", "{{.settings.channelGroupRules.resolution}} > {{.settings.channelGroupRules.availability}} > {{.settings.channelGroupRules.priority}}", "{{.settings.channelGroupRules.priority}} > {{.settings.channelGroupRules.resolution}} > {{.settings.channelGroupRules.availability}}", "{{.settings.channelGroupRules.priority}} > {{.settings.channelGroupRules.availability}} > {{.settings.channelGroupRules.resolution}}", "{{.settings.channelGroupRules.availability}} > {{.settings.channelGroupRules.resolution}} > {{.settings.channelGroupRules.priority}}", "{{.settings.channelGroupRules.availability}} > {{.settings.channelGroupRules.priority}} > {{.settings.channelGroupRules.resolution}}"];
+                var values = ["resolution,priority,availability", "resolution,availability,priority", "priority,resolution,availability", "priority,availability,resolution", "availability,resolution,priority", "availability,priority,resolution"];
+                var select = content.createSelect(text, values, data, settingsKey);
+                select.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(select);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "m3u.attributes.allow":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.m3uAttributesAllow.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var input = content.createInput("text", settingsKey, data);
+                input.setAttribute("placeholder", "{{.settings.m3uAttributesAllow.placeholder}}");
+                input.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "m3u.attributes.deny":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.m3uAttributesDeny.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var input = content.createInput("text", settingsKey, data);
+                input.setAttribute("placeholder", "{{.settings.m3uAttributesDeny.placeholder}}");
+                input.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
             case "storeBufferInRAM":
                 var tdLeft = document.createElement("TD");
                 tdLeft.innerHTML = "{{.settings.storeBufferInRAM.title}}" + ":";
@@ -457,12 +612,84 @@ class SettingsCategory {
                 setting.appendChild(tdLeft);
                 setting.appendChild(tdRight);
                 break;
+            case "probe.interval":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.probeInterval.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var text = ["{{.settings.probeInterval.disabled}}", "15 min", "30 min", "60 min", "2 h", "6 h", "12 h", "24 h"];
+                var values = ["0", "15", "30", "60", "120", "360", "720", "1440"];
+                var select = content.createSelect(text, values, data, settingsKey);
+                select.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(select);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "probe.concurrency":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.probeConcurrency.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var text = ["1", "2", "3", "4", "5", "10"];
+                var values = ["1", "2", "3", "4", "5", "10"];
+                var select = content.createSelect(text, values, data, settingsKey);
+                select.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(select);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "probe.failures":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.probeFailures.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var text = ["1", "2", "3", "5", "10"];
+                var values = ["1", "2", "3", "5", "10"];
+                var select = content.createSelect(text, values, data, settingsKey);
+                select.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(select);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "probe.dead.action":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.probeDeadAction.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var text = ["{{.settings.probeDeadAction.none}}", "{{.settings.probeDeadAction.deactivate}}", "{{.settings.probeDeadAction.backup}}"];
+                var values = ["-", "deactivate", "backup"];
+                var select = content.createSelect(text, values, data, settingsKey);
+                select.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(select);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "buffer.stall.timeout":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.bufferStallTimeout.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var text = ["5 s", "10 s", "20 s", "30 s", "60 s"];
+                var values = ["5", "10", "20", "30", "60"];
+                var select = content.createSelect(text, values, data, settingsKey);
+                select.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(select);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
+            case "buffer.stream.max.mb":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.bufferStreamMaxSize.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var text = ["16 MB", "32 MB", "64 MB", "128 MB", "256 MB", "512 MB"];
+                var values = ["16", "32", "64", "128", "256", "512"];
+                var select = content.createSelect(text, values, data, settingsKey);
+                select.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(select);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
             case "buffer":
                 var tdLeft = document.createElement("TD");
                 tdLeft.innerHTML = "{{.settings.streamBuffering.title}}" + ":";
                 var tdRight = document.createElement("TD");
-                var text = ["{{.settings.streamBuffering.info_false}}", "FFmpeg: ({{.settings.streamBuffering.info_ffmpeg}})", "VLC: ({{.settings.streamBuffering.info_vlc}})"];
-                var values = ["-", "ffmpeg", "vlc"];
+                var text = ["{{.settings.streamBuffering.info_false}}", "Threadfin: ({{.settings.streamBuffering.info_threadfin}})", "FFmpeg: ({{.settings.streamBuffering.info_ffmpeg}})", "VLC: ({{.settings.streamBuffering.info_vlc}})", "Command: ({{.settings.streamBuffering.info_command}})"];
+                var values = ["-", "threadfin", "ffmpeg", "vlc", "command"];
                 var select = content.createSelect(text, values, data, settingsKey);
                 select.setAttribute("onchange", "javascript: this.className = 'changed'");
                 tdRight.appendChild(select);
@@ -480,6 +707,17 @@ class SettingsCategory {
                 setting.appendChild(tdLeft);
                 setting.appendChild(tdRight);
                 break;
+            case "udp.interface":
+                var tdLeft = document.createElement("TD");
+                tdLeft.innerHTML = "{{.settings.udpInterface.title}}" + ":";
+                var tdRight = document.createElement("TD");
+                var input = content.createInput("text", "udp.interface", data);
+                input.setAttribute("placeholder", "{{.settings.udpInterface.placeholder}}");
+                input.setAttribute("onchange", "javascript: this.className = 'changed'");
+                tdRight.appendChild(input);
+                setting.appendChild(tdLeft);
+                setting.appendChild(tdRight);
+                break;
         }
         return setting;
     }
@@ -504,6 +742,9 @@ class SettingsCategory {
                     text = "{{.settings.authenticationAPI.description}}";
                 }
                 break;
+            case "authentication.xtream":
+                text = "{{.settings.authenticationXtream.description}}";
+                break;
             case "ThreadfinAutoUpdate":
                 text = "{{.settings.ThreadfinAutoUpdate.description}}";
                 break;
@@ -525,6 +766,36 @@ class SettingsCategory {
             case "storeBufferInRAM":
                 text = "{{.settings.storeBufferInRAM.description}}";
                 break;
+            case "buffer.stream.max.mb":
+                text = "{{.settings.bufferStreamMaxSize.description}}";
+                break;
+            case "probe.interval":
+                text = "{{.settings.probeInterval.description}}";
+                break;
+            case "probe.concurrency":
+                text = "{{.settings.probeConcurrency.description}}";
+                break;
+            case "probe.failures":
+                text = "{{.settings.probeFailures.description}}";
+                break;
+            case "probe.dead.action":
+                text = "{{.settings.probeDeadAction.description}}";
+                break;
+            case "buffer.stall.timeout":
+                text = "{{.settings.bufferStallTimeout.description}}";
+                break;
+            case "buffer.profiles":
+                text = "{{.settings.bufferProfiles.description}}";
+                break;
+            case "slates":
+                text = "{{.settings.slates.description}}";
+                break;
+            case "tuner.pools":
+                text = "{{.settings.tunerPools.description}}";
+                break;
+            case "stream.priorities":
+                text = "{{.settings.streamPriorities.description}}";
+                break;
             case "forceHttps":
                 text = "{{.settings.forceHttps.description}}";
                 break;
@@ -573,6 +844,12 @@ class SettingsCategory {
             case "vlc.options":
                 text = "{{.settings.vlcOptions.description}}";
                 break;
+            case "command.path":
+                text = "{{.settings.commandPath.description}}";
+                break;
+            case "command.options":
+                text = "{{.settings.commandOptions.description}}";
+                break;
             case "epgSource":
                 text = "{{.settings.epgSource.description}}";
                 break;
@@ -600,9 +877,24 @@ class SettingsCategory {
             case "xepg.replace.channel.title":
                 text = "{{.settings.replaceChannelTitle.description}}";
                 break;
+            case "channel.groups":
+                text = "{{.settings.channelGroups.description}}";
+                break;
+            case "channel.groups.rules":
+                text = "{{.settings.channelGroupRules.description}}";
+                break;
+            case "m3u.attributes.allow":
+                text = "{{.settings.m3uAttributesAllow.description}}";
+                break;
+            case "m3u.attributes.deny":
+                text = "{{.settings.m3uAttributesDeny.description}}";
+                break;
             case "udpxy":
                 text = "{{.settings.udpxy.description}}";
                 break;
+            case "udp.interface":
+                text = "{{.settings.udpInterface.description}}";
+                break;
             default:
                 text = "";
                 break;
@@ -638,6 +930,7 @@ class SettingsCategoryItem extends SettingsCategory {
                 case "authentication.m3u":
                 case "authentication.xml":
                 case "authentication.api":
+                case "authentication.xtream":
                     if (SERVER["settings"]["authentication.web"] == false) {
                         break;
                     }
@@ -659,6 +952,154 @@ function showSettings() {
         settingsCategory[i].createCategory();
     }
 }
+function newBufferProfile(profile) {
+    var content = new PopupContent();
+    var div = document.createElement("DIV");
+    div.className = "buffer-profile";
+    if (profile == undefined) {
+        profile = { "name": "", "path": "", "options": "", "headers": [] };
+    }
+    var keys = ["name", "path", "options", "headers"];
+    keys.forEach(key => {
+        var value = profile[key];
+        if (key == "headers") {
+            value = (value || []).join(" | ");
+        }
+        var input = content.createInput("text", "buffer.profiles", value);
+        input.setAttribute("data-key", key);
+        input.setAttribute("placeholder", "{{.settings.bufferProfiles.placeholder}}".split(",")[keys.indexOf(key)]);
+        input.setAttribute("onchange", "javascript: this.className = 'changed'");
+        div.appendChild(input);
+    });
+    return div;
+}
+function getBufferProfiles() {
+    var profiles = new Object();
+    var divs = document.getElementById("buffer-profiles").getElementsByClassName("buffer-profile");
+    for (let i = 0; i < divs.length; i++) {
+        var profile = new Object();
+        var inputs = divs[i].getElementsByTagName("INPUT");
+        for (let j = 0; j < inputs.length; j++) {
+            profile[inputs[j].getAttribute("data-key")] = inputs[j].value.trim();
+        }
+        // Profiles without a name are removed
+        if (profile["name"].length == 0) {
+            continue;
+        }
+        profile["headers"] = profile["headers"].split("|").map((h) => h.trim()).filter((h) => h.length > 0);
+        profiles[profile["name"]] = profile;
+    }
+    return profiles;
+}
+function uploadSlate(name) {
+    if (document.getElementById('upload')) {
+        document.getElementById('upload').remove();
+    }
+    var upload = document.createElement("INPUT");
+    upload.setAttribute("type", "file");
+    upload.setAttribute("accept", ".ts,video/mp2t");
+    upload.setAttribute("class", "notVisible");
+    upload.setAttribute("name", "");
+    upload.id = "upload";
+    document.body.appendChild(upload);
+    upload.click();
+    upload.onchange = function () {
+        var reader = new FileReader();
+        var file = upload.files[0];
+        if (file) {
+            reader.readAsDataURL(file);
+            reader.onload = function () {
+                var data = new Object();
+                var cmd = "uploadSlate";
+                data["base64"] = reader.result;
+                data["filename"] = name;
+                var server = new Server(cmd);
+                server.request(data);
+            };
+        }
+        else {
+            alert("File could not be loaded");
+        }
+        upload.remove();
+        return;
+    };
+}
+function removeSlate(name) {
+    var data = new Object();
+    data["filename"] = name;
+    var server = new Server("removeSlate");
+    server.request(data);
+}
+function newTunerPool(pool) {
+    var content = new PopupContent();
+    var div = document.createElement("DIV");
+    div.className = "buffer-profile";
+    if (pool == undefined) {
+        pool = { "name": "", "tuner": "" };
+    }
+    var keys = ["name", "tuner"];
+    keys.forEach(key => {
+        var input = content.createInput("text", "tuner.pools", pool[key]);
+        input.setAttribute("data-key", key);
+        input.setAttribute("placeholder", "{{.settings.tunerPools.placeholder}}".split(",")[keys.indexOf(key)]);
+        input.setAttribute("onchange", "javascript: this.className = 'changed'");
+        div.appendChild(input);
+    });
+    return div;
+}
+function getTunerPools() {
+    var pools = new Object();
+    var divs = document.getElementById("tuner-pools").getElementsByClassName("buffer-profile");
+    for (let i = 0; i < divs.length; i++) {
+        var pool = new Object();
+        var inputs = divs[i].getElementsByTagName("INPUT");
+        for (let j = 0; j < inputs.length; j++) {
+            pool[inputs[j].getAttribute("data-key")] = inputs[j].value.trim();
+        }
+        // Pools without a name or a valid tuner limit are removed
+        if (pool["name"].length == 0 || isNaN(parseInt(pool["tuner"]))) {
+            continue;
+        }
+        pool["tuner"] = parseInt(pool["tuner"]);
+        pools[pool["name"]] = pool;
+    }
+    return pools;
+}
+function newStreamPriority(rule) {
+    var content = new PopupContent();
+    var div = document.createElement("DIV");
+    div.className = "buffer-profile";
+    if (rule == undefined) {
+        rule = { "user": "", "ip": "", "userAgent": "", "channel": "", "priority": "" };
+    }
+    var keys = ["user", "ip", "userAgent", "channel", "priority"];
+    keys.forEach(key => {
+        var input = content.createInput("text", "stream.priorities", rule[key]);
+        input.setAttribute("data-key", key);
+        input.setAttribute("placeholder", "{{.settings.streamPriorities.placeholder}}".split(",")[keys.indexOf(key)]);
+        input.setAttribute("onchange", "javascript: this.className = 'changed'");
+        div.appendChild(input);
+    });
+    return div;
+}
+function getStreamPriorities() {
+    var rules = [];
+    var divs = document.getElementById("stream-priorities").getElementsByClassName("buffer-profile");
+    for (let i = 0; i < divs.length; i++) {
+        var rule = new Object();
+        var inputs = divs[i].getElementsByTagName("INPUT");
+        for (let j = 0; j < inputs.length; j++) {
+            rule[inputs[j].getAttribute("data-key")] = inputs[j].value.trim();
+        }
+        // Rules without a priority are removed
+        if (rule["priority"].length == 0 || isNaN(rule["priority"])) {
+            continue;
+        }
+        rule["priority"] = parseInt(rule["priority"]);
+        rules.push(rule);
+    }
+    return rules;
+}
 function saveSettings() {
     console.log("Save Settings");
     var cmd = "saveSettings";
@@ -686,6 +1127,19 @@ function saveSettings() {
                                 break;
                             case "buffer.timeout":
                                 value = parseFloat(value);
+                                break;
+                            // All profiles are saved together
+                            case "buffer.profiles":
+                                value = getBufferProfiles();
+                                break;
+                            // All pools are saved together
+                            case "tuner.pools":
+                                value = getTunerPools();
+                                break;
+                            // All rules are saved together
+                            case "stream.priorities":
+                                value = getStreamPriorities();
+                                break;
                         }
                         newSettings[name] = value;
                         break;
@@ -16,6 +16,7 @@ import (
 	"threadfin/internal/cli"
 	"threadfin/internal/config"
 	"threadfin/internal/maintenance"
+	"threadfin/internal/probe"
 	"threadfin/internal/storage"
 	"threadfin/internal/system"
 	"threadfin/internal/update"
@@ -48,7 +49,7 @@ const Name = "Threadfin"
 const Version = "1.2.40"
 
 // DBVersion : Datanbank Version
-const DBVersion = "0.5.0"
+const DBVersion = "0.6.0"
 
 // APIVersion : API Version
 const APIVersion = "1.2.40"
@@ -215,6 +216,8 @@ func Run() {
 		os.Exit(0)
 	}
 
+	probe.Start()
+
 	err = server.StartWebserver()
 	if err != nil {
 		cli.ShowError(err, 0)
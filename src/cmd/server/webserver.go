@@ -26,7 +26,10 @@ import (
 	"threadfin/internal/m3u"
 	"threadfin/internal/media"
 	"threadfin/internal/playlist"
+	"threadfin/internal/probe"
 	"threadfin/internal/provider"
+	"threadfin/internal/slate"
+	"threadfin/internal/stalker"
 	"threadfin/internal/storage"
 	"threadfin/internal/stream"
 	"threadfin/internal/structs"
@@ -36,6 +39,7 @@ import (
 	"threadfin/internal/webui"
 	"threadfin/internal/wizard"
 	"threadfin/internal/xepg"
+	"threadfin/internal/xtream"
 	"threadfin/web"
 
 	"github.com/gorilla/websocket"
@@ -55,6 +59,10 @@ func StartWebserver() (err error) {
 	http.HandleFunc("/stream/", Stream)
 	http.HandleFunc("/xmltv/", Threadfin)
 	http.HandleFunc("/m3u/", Threadfin)
+	http.HandleFunc("/player_api.php", Xtream)
+	http.HandleFunc("/get.php", Xtream)
+	http.HandleFunc("/xmltv.php", Xtream)
+	http.HandleFunc("/live/", Xtream)
 	http.HandleFunc("/data/", WS)
 	http.HandleFunc("/web/", Web)
 	http.HandleFunc("/download/", Download)
@@ -143,7 +151,11 @@ func Index(w http.ResponseWriter, r *http.Request) {
 
 // Stream : Web Server /stream/
 func Stream(w http.ResponseWriter, r *http.Request) {
-	var path = strings.Replace(r.RequestURI, "/stream/", "", 1)
+	var path = strings.TrimPrefix(r.URL.Path, "/stream/")
+
+	// HLS output: /stream/<id>/index.m3u8, /stream/<id>/<sequence>.ts
+	path, hlsFile, _ := strings.Cut(path, "/")
+
 	streamInfo, err := stream.GetStreamInfo(path)
 	if err != nil {
 		cli.ShowError(err, 1203)
@@ -151,8 +163,11 @@ func Stream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The last probes flagged the stream as dead: the backup channel is used (settings: probe.dead.action)
+	streamInfo, _ = probe.Backup(streamInfo)
+
 	// If an UDPxy host is set, and the stream URL is multicast (i.e. starts with 'udp://@'),
-	// then streamInfo.URL needs to be rewritten to point to UDPxy.
+	// then streamInfo.URL needs to be rewritten to point to UDPxy. Otherwise the Threadfin buffer joins the multicast group itself.
 	if config.Settings.UDPxy != "" && strings.HasPrefix(streamInfo.URL, "udp://@") {
 		streamInfo.URL = fmt.Sprintf("http://%s/udp/%s/", config.Settings.UDPxy, strings.TrimPrefix(streamInfo.URL, "udp://@"))
 	}
@@ -163,7 +178,7 @@ func Stream(w http.ResponseWriter, r *http.Request) {
 	config.SystemMutex.Unlock()
 
 	// Dont Change Source M3Us to use HTTPs when forceHttps set and Exclude Streams from https
-	if forceHttps && !noStreamHttps {
+	if forceHttps && !noStreamHttps && !stalker.IsStreamURL(streamInfo.URL) {
 		u, err := url.Parse(streamInfo.URL)
 		if err == nil {
 			u.Scheme = "https"
@@ -175,9 +190,15 @@ func Stream(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if r.Method == "HEAD" {
+	if r.Method == "HEAD" && len(hlsFile) == 0 {
+		headURL, err := stalker.Resolve(streamInfo.URL)
+		if err != nil {
+			cli.ShowError(err, 0)
+			web.HttpStatusError(w, 502)
+			return
+		}
 		client := &http.Client{}
-		req, err := http.NewRequest("HEAD", streamInfo.URL, nil)
+		req, err := http.NewRequest("HEAD", headURL, nil)
 		if err != nil {
 			cli.ShowError(err, 1501)
 			web.HttpStatusError(w, 405)
@@ -208,34 +229,23 @@ func Stream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var playListBuffer string
-	config.SystemMutex.Lock()
-	playListInterface := config.Settings.Files.M3U[streamInfo.PlaylistID]
-	if playListInterface == nil {
-		playListInterface = config.Settings.Files.HDHR[streamInfo.PlaylistID]
-	}
+	var playListBuffer = getPlaylistBuffer(streamInfo.PlaylistID)
 
-	if playListMap, ok := playListInterface.(map[string]interface{}); ok {
-		if bufferValue, exists := playListMap["buffer"]; exists && bufferValue != nil {
-			if buffer, ok := bufferValue.(string); ok {
-				playListBuffer = buffer
-			}
+	if len(hlsFile) > 0 {
+
+		if playListBuffer == "-" {
+			cli.ShowInfo(fmt.Sprintf("Streaming Info:HLS output is only available with a buffer [%s]", streamInfo.Name))
+			web.HttpStatusError(w, 404)
+			return
 		}
+
+		stream.HLS(path, streamInfo, getBufferProfile(r, streamInfo, playListBuffer), hlsFile, w, r)
+		return
 	}
-	config.SystemMutex.Unlock()
 
 	switch playListBuffer {
 	case "-":
 		cli.ShowInfo(fmt.Sprintf("Buffer:false [%s]", playListBuffer))
-	case "threadfin":
-		if strings.Contains(streamInfo.URL, "rtsp://") || strings.Contains(streamInfo.URL, "rtp://") {
-			err = errors.New("RTSP and RTP streams are not supported")
-			cli.ShowError(err, 2004)
-			cli.ShowInfo("Streaming URL:" + streamInfo.URL)
-			http.Redirect(w, r, streamInfo.URL, http.StatusFound)
-			return
-		}
-		cli.ShowInfo(fmt.Sprintf("Buffer:true [%s]", playListBuffer))
 	default:
 		cli.ShowInfo(fmt.Sprintf("Buffer:true [%s]", playListBuffer))
 	}
@@ -245,14 +255,64 @@ func Stream(w http.ResponseWriter, r *http.Request) {
 
 	switch playListBuffer {
 	case "-":
+		// Stalker portals: the link of the stream is created at play time, with a buffer the link is created by the buffer
+		streamInfo.URL, err = stalker.Resolve(streamInfo.URL)
+		if err != nil {
+			cli.ShowError(err, 0)
+			web.HttpStatusError(w, 502)
+			return
+		}
+
 		cli.ShowInfo("Streaming URL:" + streamInfo.URL)
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		http.Redirect(w, r, streamInfo.URL, http.StatusFound)
 		cli.ShowInfo("Streaming Info:URL was passed to the client.")
 		cli.ShowInfo("Streaming Info:Threadfin is no longer involved, the client connects directly to the streaming server.")
 	default:
-		stream.Buffering(streamInfo.PlaylistID, streamInfo.URL, streamInfo.BackupChannel1, streamInfo.BackupChannel2, streamInfo.BackupChannel3, streamInfo.Name, w, r)
+		stream.Buffering(streamInfo.PlaylistID, streamInfo.URL, streamInfo.BackupChannels, getBufferProfile(r, streamInfo, playListBuffer), streamInfo.Name, w, r)
+	}
+}
+
+// getPlaylistBuffer : Buffer of the playlist (M3U, HDHR)
+func getPlaylistBuffer(playlistID string) (playListBuffer string) {
+	config.SystemMutex.Lock()
+	defer config.SystemMutex.Unlock()
+
+	playListInterface := config.Settings.Files.M3U[playlistID]
+	if playListInterface == nil {
+		playListInterface = config.Settings.Files.HDHR[playlistID]
+	}
+
+	if playListMap, ok := playListInterface.(map[string]interface{}); ok {
+		if bufferValue, exists := playListMap["buffer"]; exists && bufferValue != nil {
+			if buffer, ok := bufferValue.(string); ok {
+				playListBuffer = buffer
+			}
+		}
+	}
+
+	return
+}
+
+// getBufferProfile : Buffer profile selected by the client (?profile=), each profile is a separate buffer session
+func getBufferProfile(r *http.Request, streamInfo structs.StreamInfo, playListBuffer string) (bufferProfile string) {
+
+	bufferProfile = streamInfo.BufferProfile
+
+	if profile := r.URL.Query().Get("profile"); len(profile) > 0 {
+
+		if _, ok := config.Settings.BufferProfiles[profile]; !ok {
+			cli.ShowWarning(4010)
+		} else if playListBuffer != "ffmpeg" && playListBuffer != "vlc" && playListBuffer != "command" {
+			cli.ShowInfo(fmt.Sprintf("Buffer Profile:%s is only used with FFmpeg, VLC or command [%s]", profile, playListBuffer))
+		} else {
+			cli.ShowInfo(fmt.Sprintf("Buffer Profile:%s", profile))
+			bufferProfile = profile
+		}
+
 	}
+
+	return
 }
 
 // Auto : HDHR routing (wird derzeit nicht benutzt)
@@ -264,7 +324,7 @@ func Auto(w http.ResponseWriter, r *http.Request) {
 // Threadfin : Web Server /xmltv/ und /m3u/
 func Threadfin(w http.ResponseWriter, r *http.Request) {
 
-	var requestType, groupTitle, file, content, contentType string
+	var requestType, groupTitle, bufferProfile, file, content, contentType string
 	var err error
 	var path = strings.TrimPrefix(r.URL.Path, "/")
 	var groups = []string{}
@@ -314,6 +374,7 @@ func Threadfin(w http.ResponseWriter, r *http.Request) {
 		}
 
 		groupTitle = r.URL.Query().Get("group-title")
+		bufferProfile = r.URL.Query().Get("profile")
 
 		config.SystemMutex.Lock()
 		m3uFilePath := config.System.Folder.Data + "threadfin.m3u"
@@ -343,7 +404,7 @@ func Threadfin(w http.ResponseWriter, r *http.Request) {
 			groups = strings.Split(groupTitle, ",")
 		}
 
-		content, err = m3u.Build(groups)
+		content, err = m3u.Build(groups, bufferProfile)
 		if err != nil {
 			cli.ShowError(err, 000)
 		}
@@ -365,6 +426,145 @@ func Threadfin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Xtream : Web Server Xtream Codes API /player_api.php, /get.php, /xmltv.php und /live/
+func Xtream(w http.ResponseWriter, r *http.Request) {
+
+	config.SystemMutex.Lock()
+	if config.Settings.HttpThreadfinDomain != "" {
+		system.SetGlobalDomain(utilities.GetBaseUrl(config.Settings.HttpThreadfinDomain, config.Settings.Port))
+	} else {
+		system.SetGlobalDomain(r.Host)
+	}
+	epgSource := config.Settings.EpgSource
+	config.SystemMutex.Unlock()
+
+	// The channels of the API are the XEPG channels
+	if epgSource != "XEPG" {
+		cli.ShowInfo("Xtream:" + cli.GetErrMsg(2106))
+		web.HttpStatusError(w, 404)
+		return
+	}
+
+	var username = r.URL.Query().Get("username")
+	var password = r.URL.Query().Get("password")
+	var streamFile string
+
+	// /live/<username>/<password>/<stream ID>.<ts|m3u8>
+	if strings.HasPrefix(r.URL.Path, "/live/") {
+
+		var parts = strings.Split(strings.TrimPrefix(r.URL.Path, "/live/"), "/")
+		if len(parts) != 3 {
+			web.HttpStatusError(w, 404)
+			return
+		}
+
+		username, password, streamFile = parts[0], parts[1], parts[2]
+
+	}
+
+	user, err := authentication.XtreamAuth(username, password)
+	if err != nil {
+
+		cli.ShowError(err, 000)
+
+		// The apps show the login as failed (auth: 0)
+		if r.URL.Path == "/player_api.php" {
+			w.Header().Set("Content-Type", "application/json")
+			err = json.NewEncoder(w).Encode(xtream.Account(username, password, false))
+			if err != nil {
+				cli.ShowError(err, 000)
+			}
+			return
+		}
+
+		web.HttpStatusError(w, 403)
+		return
+	}
+
+	// Stream priorities and sessions only use the verified user
+	if len(user) > 0 {
+		r = client.WithUser(r, user)
+	}
+
+	switch r.URL.Path {
+
+	case "/player_api.php":
+		var response any
+		response, err = xtream.PlayerAPI(r.URL.Query())
+		if err != nil {
+			cli.ShowError(err, 000)
+			web.HttpStatusError(w, 400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(response)
+
+	case "/get.php":
+		w.Header().Set("Content-Type", "audio/x-mpegurl")
+		_, err = w.Write(xtream.GetPlaylist(username, password, r.URL.Query().Get("output")))
+
+	case "/xmltv.php":
+		config.SystemMutex.Lock()
+		file := config.System.File.XML
+		config.SystemMutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		http.ServeFile(w, r, file)
+
+	default:
+		xtreamStream(w, r, streamFile)
+
+	}
+
+	if err != nil {
+		cli.ShowError(err, 000)
+	}
+}
+
+// xtreamStream : Live stream of the Xtream Codes API, the stream is passed to /stream/ (buffer, backup channels, HLS)
+func xtreamStream(w http.ResponseWriter, r *http.Request, streamFile string) {
+
+	id, extension, _ := strings.Cut(streamFile, ".")
+
+	streamID, err := strconv.Atoi(id)
+	if err != nil {
+		web.HttpStatusError(w, 404)
+		return
+	}
+
+	liveStream, ok := xtream.FindStream(streamID)
+	if !ok {
+		cli.ShowInfo(fmt.Sprintf("Xtream:Stream %d not found", streamID))
+		web.HttpStatusError(w, 404)
+		return
+	}
+
+	var channel = liveStream.Channel
+
+	config.SystemMutex.Lock()
+	streamingURL, err := stream.CreateURL("M3U", channel.FileM3UID, channel.XChannelID, channel.XName, channel.URL, channel.BackupChannels, channel.XBufferProfile)
+	config.SystemMutex.Unlock()
+	if err != nil {
+		cli.ShowError(err, 000)
+		web.HttpStatusError(w, 500)
+		return
+	}
+
+	var urlID = path.Base(streamingURL)
+
+	// HLS needs a buffer, without buffer the client is redirected to the provider
+	if extension == "m3u8" && getPlaylistBuffer(channel.FileM3UID) != "-" {
+		http.Redirect(w, r, "/stream/"+urlID+"/index.m3u8", http.StatusFound)
+		return
+	}
+
+	r = r.Clone(r.Context())
+	r.URL.Path = "/stream/" + urlID
+
+	Stream(w, r)
+}
+
 // Images : Image Cache /images/
 func Images(w http.ResponseWriter, r *http.Request) {
 
@@ -652,8 +852,34 @@ func WS(w http.ResponseWriter, r *http.Request) {
 			}
 
 		case "probeChannel":
-			resolution, frameRate, audioChannels, _ := m3u.ProbeChannel(request)
-			response.ProbeInfo = structs.ProbeInfoStruct{Resolution: resolution, FrameRate: frameRate, AudioChannel: audioChannels}
+			var probeErr error
+			response.ProbeInfo, probeErr = m3u.ProbeChannel(request)
+
+			// The metadata is saved with the XEPG channels of the stream (M3U, XMLTV and HDHomeRun lineup)
+			if probeErr == nil {
+				err = probe.SaveMetadata(request.ProbeURL, response.ProbeInfo)
+			}
+
+		// Channel preview: HLS output of the buffer
+		case "previewChannel":
+			response.PreviewURL, err = stream.PreviewURL(request.PreviewPlaylistID, request.PreviewURL)
+
+		// Stream sessions (the sessions are part of the default response data)
+		case "getSessions":
+
+		case "killSession":
+			err = client.TerminateSession(request.SessionID)
+
+		// Slates: the clip is uploaded as base64 (request.Filename: name of the slate)
+		case "uploadSlate":
+			if len(request.Base64) > 0 {
+				err = slate.Upload(request.Filename, request.Base64)
+				response.OpenMenu = strconv.Itoa(utilities.IndexOfString("settings", config.System.WEB.Menu))
+			}
+
+		case "removeSlate":
+			err = slate.Remove(request.Filename)
+			response.OpenMenu = strconv.Itoa(utilities.IndexOfString("settings", config.System.WEB.Menu))
 
 		default:
 			fmt.Println("+ + + + + + + + + + +", request.Cmd)
@@ -928,6 +1154,19 @@ func API(w http.ResponseWriter, r *http.Request) {
 			  "version.api": "1.1.0",
 			  "version.threadfin": "1.3.0"
 			}
+
+			Aktive Stream Sessions (Clients):
+			curl -X POST -H "Content-Type: application/json" -d '{"cmd":"sessions"}' http://localhost:34400/api/
+
+			Antwort:
+			{
+			  "status": true,
+			  "sessions": [{"id": "1", "playlistID": "M1", "streamID": 0, "channelName": "Das Erste", "ip": "192.168.1.10", "userAgent": "Lavf/58.76.100",
+			  	"start": "2024-01-01T20:15:00Z", "bytesSent": 52428800, "bitrate": 4200000}]
+			}
+
+			Session beenden:
+			curl -X POST -H "Content-Type: application/json" -d '{"cmd":"kill.session","id":"1"}' http://localhost:34400/api/
 	*/
 
 	if config.Settings.HttpThreadfinDomain != "" {
@@ -1076,6 +1315,12 @@ func API(w http.ResponseWriter, r *http.Request) {
 	case "update.xepg":
 		xepg.BuildXEPG(false)
 
+	case "sessions":
+		response.Sessions = client.GetSessions()
+
+	case "kill.session":
+		err = client.TerminateSession(request.ID)
+
 	default:
 		err = errors.New(cli.GetErrMsg(5000))
 
@@ -1159,6 +1404,7 @@ func setDefaultResponseData(response structs.ResponseStruct, data bool) (default
 	defaults.ClientInfo.ActivePlaylist = playlist.GetActiveCount()
 	defaults.ClientInfo.TotalClients = config.Settings.Tuner
 	defaults.ClientInfo.TotalPlaylist = totalPlaylistCount
+	defaults.Sessions = client.GetSessions()
 	defaults.Notification = config.System.Notification
 	defaults.Log = config.WebScreenLog
 
@@ -1199,6 +1445,8 @@ func setDefaultResponseData(response structs.ResponseStruct, data bool) (default
 		}
 
 		defaults.Settings = config.Settings
+		defaults.Slates = slate.GetCustom()
+		defaults.Probes = probe.Results()
 
 		defaults.Data.Playlist.M3U.Groups.Text = config.Data.Playlist.M3U.Groups.Text
 		defaults.Data.Playlist.M3U.Groups.Value = config.Data.Playlist.M3U.Groups.Value
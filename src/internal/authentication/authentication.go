@@ -617,6 +617,7 @@ func ActivatedSystemAuthentication(config string) (err error) {
 	defaults["authentication.pms"] = false
 	defaults["authentication.xml"] = false
 	defaults["authentication.api"] = false
+	defaults["authentication.xtream"] = false
 	err = SetDefaultUserData(defaults)
 
 	return
@@ -646,6 +647,7 @@ func CreateFirstUserForAuthentication(username, password string) (token string,
 	userData["authentication.m3u"] = true
 	userData["authentication.xml"] = true
 	userData["authentication.api"] = false
+	userData["authentication.xtream"] = true
 	userData["defaultUser"] = true
 
 	userID, err := GetUserID(token)
@@ -765,3 +767,28 @@ func UrlAuth(r *http.Request, requestType string) (err error) {
 
 	return
 }
+
+// XtreamAuth : Credentials of the Xtream Codes API (player_api.php, get.php, xmltv.php, /live/), the apps send them in the URL.
+// Without authentication.xtream every user is accepted if the authentication is enabled (authentication.web), otherwise any credentials.
+// The user is only returned if the credentials have been verified.
+func XtreamAuth(username, password string) (user string, err error) {
+
+	if !config.Settings.AuthenticationWEB {
+		return
+	}
+
+	token, err := UserAuthentication(username, password)
+	if err != nil {
+		return
+	}
+
+	if config.Settings.AuthenticationXtream {
+		if err = CheckAuthorizationLevel(token, "authentication.xtream"); err != nil {
+			return
+		}
+	}
+
+	user = username
+
+	return
+}
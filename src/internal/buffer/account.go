@@ -0,0 +1,121 @@
+package buffer
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+)
+
+// AccountURL : Streaming URL with the credentials of the account of the stream (provider: accounts).
+// The URLs of the playlist contain the credentials of the first account, they are replaced in the user info, the credential segments
+// of the path (/<username>/<password>/, token based URLs: /<token>/) and the query values. The order of the query is kept.
+func AccountURL(streamURL string, accounts []structs.ProviderAccount, account int) string {
+
+	if account <= 0 || account >= len(accounts) {
+		return streamURL
+	}
+
+	u, err := url.Parse(streamURL)
+	if err != nil {
+		return streamURL
+	}
+
+	var first, current = accounts[0], accounts[account]
+
+	var replace = func(value string) string {
+
+		switch {
+
+		case len(first.Username) > 0 && value == first.Username:
+			return current.Username
+
+		case len(first.Password) > 0 && value == first.Password:
+			return current.Password
+
+		}
+
+		return value
+	}
+
+	if u.User != nil {
+		if password, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(replace(u.User.Username()), replace(password))
+		} else {
+			u.User = url.User(replace(u.User.Username()))
+		}
+	}
+
+	var segments = strings.Split(u.Path, "/")
+	for i := 0; i < len(segments); i++ {
+
+		switch {
+
+		case len(first.Username) > 0 && segments[i] == first.Username && i+1 < len(segments) && segments[i+1] == first.Password:
+			segments[i], segments[i+1] = current.Username, current.Password
+			i++
+
+		case len(first.Username) == 0 && len(first.Password) > 0 && segments[i] == first.Password:
+			segments[i] = current.Password
+
+		}
+
+	}
+
+	if path := strings.Join(segments, "/"); path != u.Path {
+		u.Path, u.RawPath = path, ""
+	}
+
+	// The values are replaced in the raw query, the other parameters are not encoded again
+	if len(u.RawQuery) > 0 {
+
+		var parameters = strings.Split(u.RawQuery, "&")
+		for i, parameter := range parameters {
+
+			key, value, ok := strings.Cut(parameter, "=")
+			if !ok {
+				continue
+			}
+
+			if unescaped, err := url.QueryUnescape(value); err == nil {
+				if replaced := replace(unescaped); replaced != unescaped {
+					parameters[i] = key + "=" + url.QueryEscape(replaced)
+				}
+			}
+
+		}
+
+		u.RawQuery = strings.Join(parameters, "&")
+
+	}
+
+	return u.String()
+}
+
+// countAccountError : The stream could not be played with the account, the error is counted in the statistics of the provider (counter.error).
+// The counter is kept in the settings in memory and saved with the next update of the provider, like the download errors.
+func countAccountError(playlist structs.Playlist, stream structs.ThisStream, err error) {
+
+	if len(playlist.Accounts) == 0 || stream.Account >= len(playlist.Accounts) {
+		return
+	}
+
+	var account = playlist.Accounts[stream.Account]
+	cli.ShowInfo(fmt.Sprintf("Streaming Status:Playlist: %s - Account %d (%s) failed: %s", playlist.PlaylistName, stream.Account+1, account.Username, err))
+
+	config.SystemMutex.Lock()
+	defer config.SystemMutex.Unlock()
+
+	for _, files := range []map[string]any{config.Settings.Files.M3U, config.Settings.Files.HDHR} {
+
+		if data, ok := files[playlist.PlaylistID].(map[string]any); ok {
+			if counter, ok := data["counter.error"].(float64); ok {
+				data["counter.error"] = counter + 1
+			}
+		}
+
+	}
+
+}
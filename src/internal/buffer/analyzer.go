@@ -0,0 +1,417 @@
+package buffer
+
+import (
+	"sort"
+	"sync"
+	"threadfin/internal/structs"
+	"time"
+)
+
+// Health status of a stream (StreamHealth.Status)
+const (
+	HealthOK      = "ok"
+	HealthFrozen  = "frozen"  // Data arrives, but the video has not progressed within the stall timeout
+	HealthCorrupt = "corrupt" // Too many continuity counter errors, packets of the streaming server are lost
+)
+
+const (
+	nullPID        = 0x1fff
+	pcrMaxInterval = 9000    // 100 ms (90 kHz), max. PCR interval of ISO/IEC 13818-1
+	pcrWrap        = 1 << 33 // 33 bit PCR base
+	healthWindow   = 10 * time.Second
+	corruptRatio   = 0.01 // Packets with continuity counter errors within the health window
+	corruptPackets = 1000 // Min. number of packets within the health window for the corrupt status
+)
+
+// tsAnalyzer : Parses the MPEG-TS that is written into the broadcaster (PID table, PAT / PMT, continuity counters, PCR, null packets)
+type tsAnalyzer struct {
+	mu   sync.Mutex
+	rest []byte // Incomplete packet of the last write
+
+	pids    map[uint16]*pidState
+	pmtPIDs map[uint16]bool
+	pcrPID  uint16
+	lastPCR int64
+
+	packets    int64
+	nulls      int64
+	syncErrors int64
+	ccErrors   int64
+	pcrGaps    int64
+	pcrMax     int64 // 90 kHz
+
+	video        bool // The PMT of the current source contains a video stream
+	lastVideoPTS int64
+	lastVideo    time.Time
+
+	windowStart    time.Time
+	windowPackets  int64
+	windowCCErrors int64
+	ccErrorRatio   float64 // Last complete health window
+}
+
+type pidState struct {
+	kind       string
+	streamType byte
+	packets    int64
+	ccErrors   int64
+	cc         int // -1: no packet since the last discontinuity
+	scrambled  bool
+}
+
+func newTSAnalyzer() *tsAnalyzer {
+
+	var a = &tsAnalyzer{pids: make(map[uint16]*pidState)}
+	a.discontinuity()
+
+	return a
+}
+
+// discontinuity : The source of the stream changes (e.g. backup channel). Continuity counters, PCR and the program tables start again, the counters are kept.
+func (a *tsAnalyzer) discontinuity() {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.rest = nil
+	a.pmtPIDs = make(map[uint16]bool)
+	a.pcrPID = nullPID
+	a.lastPCR = -1
+	a.video = false
+	a.lastVideoPTS = -1
+	a.lastVideo = time.Now()
+	a.windowStart = time.Now()
+	a.windowPackets, a.windowCCErrors = 0, 0
+	a.ccErrorRatio = 0
+
+	for _, pid := range a.pids {
+		pid.cc = -1
+	}
+
+}
+
+// write : Analyzes the packets of the data, incomplete packets are completed with the next write
+func (a *tsAnalyzer) write(data []byte) {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.rest) > 0 {
+
+		var missing = tsPacketSize - len(a.rest)
+		if len(data) < missing {
+			a.rest = append(a.rest, data...)
+			return
+		}
+
+		a.packet(append(a.rest, data[:missing]...))
+		data = data[missing:]
+		a.rest = nil
+
+	}
+
+	for len(data) > 0 {
+
+		// Lost sync: continue with the next sync byte
+		if data[0] != 0x47 {
+			a.syncErrors++
+			var i = 1
+			for i < len(data) && data[i] != 0x47 {
+				i++
+			}
+			data = data[i:]
+			continue
+		}
+
+		if len(data) < tsPacketSize {
+			a.rest = append([]byte{}, data...)
+			break
+		}
+
+		a.packet(data[:tsPacketSize])
+		data = data[tsPacketSize:]
+
+	}
+
+	if time.Since(a.windowStart) >= healthWindow {
+
+		a.ccErrorRatio = 0
+		if a.windowPackets >= corruptPackets {
+			a.ccErrorRatio = float64(a.windowCCErrors) / float64(a.windowPackets)
+		}
+
+		a.windowStart = time.Now()
+		a.windowPackets, a.windowCCErrors = 0, 0
+
+	}
+
+}
+
+func (a *tsAnalyzer) packet(p []byte) {
+
+	var pid = uint16(p[1]&0x1f)<<8 | uint16(p[2])
+
+	a.packets++
+	a.windowPackets++
+
+	if pid == nullPID {
+		a.nulls++
+		return
+	}
+
+	state, ok := a.pids[pid]
+	if !ok {
+		state = &pidState{cc: -1}
+		a.pids[pid] = state
+	}
+
+	state.packets++
+	state.scrambled = p[3]>>6 != 0
+
+	var adaptation = p[3]&0x20 != 0
+	var payload = p[3]&0x10 != 0
+	var discontinuity = adaptation && p[4] > 0 && p[5]&0x80 != 0
+
+	// Continuity counter: +1 for each packet with payload, one duplicate packet is allowed
+	if payload {
+
+		var cc = int(p[3] & 0x0f)
+		if state.cc >= 0 && !discontinuity && cc != state.cc && cc != (state.cc+1)&0x0f {
+			state.ccErrors++
+			a.ccErrors++
+			a.windowCCErrors++
+		}
+		state.cc = cc
+
+	}
+
+	if adaptation && p[4] >= 7 && p[5]&0x10 != 0 {
+		a.pcr(pid, p, discontinuity)
+	}
+
+	// Scrambled video: the PES header is encrypted, the freeze of the video can not be detected
+	if state.scrambled && state.kind == "video" {
+		a.lastVideo = time.Now()
+		return
+	}
+
+	if !payload || p[1]&0x40 == 0 {
+		return
+	}
+
+	var data = p[4:]
+	if adaptation {
+		if int(p[4])+1 >= len(data) {
+			return
+		}
+		data = data[p[4]+1:]
+	}
+
+	switch {
+
+	case pid == 0:
+		state.kind = "pat"
+		a.parsePAT(data)
+
+	case a.pmtPIDs[pid]:
+		state.kind = "pmt"
+		a.parsePMT(data)
+
+	case state.kind == "video":
+		if pts, ok := pesPTS(data); ok && pts != a.lastVideoPTS {
+			a.lastVideoPTS = pts
+			a.lastVideo = time.Now()
+		}
+
+	}
+
+}
+
+// pcr : Interval to the last PCR of the PCR PID (PMT, without PMT the first PID with a PCR)
+func (a *tsAnalyzer) pcr(pid uint16, p []byte, discontinuity bool) {
+
+	if a.pcrPID == nullPID {
+		a.pcrPID = pid
+	}
+
+	if pid != a.pcrPID {
+		return
+	}
+
+	var pcr = int64(p[6])<<25 | int64(p[7])<<17 | int64(p[8])<<9 | int64(p[9])<<1 | int64(p[10])>>7
+
+	if a.lastPCR >= 0 && !discontinuity {
+
+		var interval = (pcr - a.lastPCR + pcrWrap) % pcrWrap
+
+		// Backwards: the interval is nearly a complete wrap
+		if interval > pcrMaxInterval {
+			a.pcrGaps++
+		} else {
+			a.pcrMax = max(a.pcrMax, interval)
+		}
+
+	}
+
+	a.lastPCR = pcr
+
+}
+
+// parsePAT : PMT PIDs of the programs (section within one packet)
+func (a *tsAnalyzer) parsePAT(data []byte) {
+
+	section, ok := psiSection(data, 0x00)
+	if !ok {
+		return
+	}
+
+	for i := 8; i+4 <= len(section)-4; i += 4 {
+
+		var program = uint16(section[i])<<8 | uint16(section[i+1])
+		if program == 0 {
+			continue // NIT
+		}
+
+		a.pmtPIDs[uint16(section[i+2]&0x1f)<<8|uint16(section[i+3])] = true
+
+	}
+
+}
+
+// parsePMT : PCR PID and the elementary streams of the program
+func (a *tsAnalyzer) parsePMT(data []byte) {
+
+	section, ok := psiSection(data, 0x02)
+	if !ok || len(section) < 16 {
+		return
+	}
+
+	a.pcrPID = uint16(section[8]&0x1f)<<8 | uint16(section[9])
+
+	var i = 12 + (int(section[10]&0x0f)<<8 | int(section[11]))
+
+	for i+5 <= len(section)-4 {
+
+		var streamType = section[i]
+		var pid = uint16(section[i+1]&0x1f)<<8 | uint16(section[i+2])
+
+		state, ok := a.pids[pid]
+		if !ok {
+			state = &pidState{cc: -1}
+			a.pids[pid] = state
+		}
+
+		state.streamType = streamType
+		state.kind = streamKind(streamType)
+
+		if state.kind == "video" {
+			a.video = true
+		}
+
+		i += 5 + (int(section[i+3]&0x0f)<<8 | int(section[i+4]))
+
+	}
+
+}
+
+// health : Current metrics of the stream. Frozen: the PMT contains a video stream, but its PTS has not changed within the timeout (scrambled video is not checked).
+func (a *tsAnalyzer) health(freezeTimeout time.Duration) (health structs.StreamHealth) {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	health = structs.StreamHealth{
+		Packets:        a.packets,
+		CCErrors:       a.ccErrors,
+		PCRGaps:        a.pcrGaps,
+		PCRMaxInterval: a.pcrMax / 90,
+		SyncErrors:     a.syncErrors,
+		PIDs:           []structs.PIDHealth{},
+	}
+
+	if a.packets > 0 {
+		health.NullRatio = float64(a.nulls) / float64(a.packets)
+	}
+
+	for pid, state := range a.pids {
+
+		health.PIDs = append(health.PIDs, structs.PIDHealth{
+			PID:        pid,
+			Type:       state.kind,
+			StreamType: state.streamType,
+			Packets:    state.packets,
+			CCErrors:   state.ccErrors,
+			Scrambled:  state.scrambled,
+		})
+
+	}
+
+	sort.Slice(health.PIDs, func(i, j int) bool {
+		return health.PIDs[i].PID < health.PIDs[j].PID
+	})
+
+	switch {
+
+	case a.packets == 0:
+
+	case a.video && time.Since(a.lastVideo) >= freezeTimeout:
+		health.Status = HealthFrozen
+
+	case a.ccErrorRatio > corruptRatio:
+		health.Status = HealthCorrupt
+
+	default:
+		health.Status = HealthOK
+
+	}
+
+	return
+}
+
+// psiSection : PSI section with the table ID at the start of the payload (pointer field), the section has to be complete within the packet
+func psiSection(data []byte, tableID byte) (section []byte, ok bool) {
+
+	if len(data) == 0 || int(data[0])+1 >= len(data) {
+		return
+	}
+
+	section = data[data[0]+1:]
+	if len(section) < 12 || section[0] != tableID {
+		return nil, false
+	}
+
+	var length = 3 + (int(section[1]&0x0f)<<8 | int(section[2]))
+	if length > len(section) {
+		return nil, false
+	}
+
+	return section[:length], true
+}
+
+// pesPTS : PTS of a PES header at the start of the payload
+func pesPTS(data []byte) (pts int64, ok bool) {
+
+	if len(data) < 14 || data[0] != 0 || data[1] != 0 || data[2] != 1 || data[6]&0xc0 != 0x80 || data[7]&0x80 == 0 {
+		return
+	}
+
+	var b = data[9:14]
+
+	return int64(b[0]>>1&0x07)<<30 | int64(b[1])<<22 | int64(b[2]>>1)<<15 | int64(b[3])<<7 | int64(b[4]>>1), true
+}
+
+// streamKind : Type of the elementary stream (PMT stream type)
+func streamKind(streamType byte) string {
+
+	switch streamType {
+
+	case 0x01, 0x02, 0x10, 0x1b, 0x24, 0x42, 0xd1, 0xea:
+		return "video"
+
+	case 0x03, 0x04, 0x0f, 0x11, 0x81, 0x82, 0x87:
+		return "audio"
+
+	}
+
+	return "data"
+}
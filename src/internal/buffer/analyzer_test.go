@@ -0,0 +1,180 @@
+package buffer
+
+import (
+	"testing"
+	"time"
+)
+
+// testProgram : PAT (PMT PID 0x20) and PMT (H.264 video 0x100 with PCR, AAC audio 0x101)
+func testProgram() (data []byte) {
+
+	var pat = []byte{0x00, 0x00, 0xb0, 0x0d, 0x00, 0x01, 0xc1, 0x00, 0x00, 0x00, 0x01, 0xe0, 0x20, 0, 0, 0, 0}
+	var pmt = []byte{0x02, 0xb0, 0x17, 0x00, 0x01, 0xc1, 0x00, 0x00, 0xe1, 0x00, 0xf0, 0x00, 0x1b, 0xe1, 0x00, 0xf0, 0x00, 0x0f, 0xe1, 0x01, 0xf0, 0x00, 0, 0, 0, 0}
+
+	data = append(data, testPacket(0x00, 0, pat)...)
+	data = append(data, testPacket(0x20, 0, append([]byte{0}, pmt...))...)
+
+	return
+}
+
+// testPacket : Packet with payload unit start indicator, the rest of the packet is filled with 0xff
+func testPacket(pid uint16, cc byte, payload []byte) []byte {
+
+	var p = make([]byte, tsPacketSize)
+	p[0], p[1], p[2], p[3] = 0x47, 0x40|byte(pid>>8), byte(pid), 0x10|cc&0x0f
+
+	for i := copy(p[4:], payload) + 4; i < tsPacketSize; i++ {
+		p[i] = 0xff
+	}
+
+	return p
+}
+
+// testVideo : Video packet with PCR (adaptation field) and PES header with PTS
+func testVideo(cc byte, pcr, pts int64) []byte {
+
+	var p = testPacket(0x100, cc, nil)
+	p[3] |= 0x20
+	p[4], p[5] = 7, 0x10
+	p[6], p[7], p[8], p[9], p[10] = byte(pcr>>25), byte(pcr>>17), byte(pcr>>9), byte(pcr>>1), byte(pcr<<7)
+
+	copy(p[12:], []byte{0, 0, 1, 0xe0, 0, 0, 0x80, 0x80, 5,
+		0x21 | byte(pts>>29)&0x0e, byte(pts >> 22), byte(pts>>14) | 0x01, byte(pts >> 7), byte(pts<<1) | 0x01})
+
+	return p
+}
+
+func TestAnalyzer(t *testing.T) {
+
+	var a = newTSAnalyzer()
+	var data = testProgram()
+
+	// 40 ms apart, one PCR gap (500 ms) and one lost packet (continuity counter 6 is missing)
+	for i, cc := range []byte{0, 1, 2, 3, 4, 5, 7, 8} {
+
+		var pcr = int64(90000 + i*3600)
+		if i >= 4 {
+			pcr += 45000
+		}
+
+		data = append(data, testVideo(cc, pcr, pcr+9000)...)
+
+		// Duplicate packet
+		if i == 2 {
+			data = append(data, testVideo(cc, pcr, pcr+9000)...)
+		}
+
+	}
+
+	data = append(data, testPacket(nullPID, 0, nil)...)
+	data = append(data, 0x00, 0x01)
+
+	// Packets are split over several writes
+	for len(data) > 0 {
+		var n = min(len(data), 100)
+		a.write(data[:n])
+		data = data[n:]
+	}
+
+	var health = a.health(time.Minute)
+
+	if health.Status != HealthOK || health.Packets != 12 || health.CCErrors != 1 || health.PCRGaps != 1 || health.PCRMaxInterval != 40 || health.SyncErrors != 1 {
+		t.Fatalf("unexpected health: %+v", health)
+	}
+
+	if health.NullRatio != 1.0/12 {
+		t.Errorf("unexpected null ratio: %f", health.NullRatio)
+	}
+
+	var expected = []struct {
+		pid        uint16
+		kind       string
+		streamType byte
+		packets    int64
+	}{{0x00, "pat", 0, 1}, {0x20, "pmt", 0, 1}, {0x100, "video", 0x1b, 9}, {0x101, "audio", 0x0f, 0}}
+
+	if len(health.PIDs) != len(expected) {
+		t.Fatalf("unexpected PIDs: %+v", health.PIDs)
+	}
+
+	for i, e := range expected {
+		if pid := health.PIDs[i]; pid.PID != e.pid || pid.Type != e.kind || pid.StreamType != e.streamType || pid.Packets != e.packets {
+			t.Errorf("unexpected PID: %+v", pid)
+		}
+	}
+
+	// The video does not progress: repeated PTS
+	a.write(testVideo(9, 200000, 200000))
+	a.lastVideo = time.Now().Add(-2 * time.Second)
+	a.write(testVideo(10, 203600, 200000))
+
+	if status := a.health(time.Second).Status; status != HealthFrozen {
+		t.Errorf("expected frozen video, got %s", status)
+	}
+
+	// New source: no continuity counter error at the switch
+	a.discontinuity()
+	a.write(testVideo(3, 300000, 300000))
+
+	if health := a.health(time.Second); health.Status != HealthOK || health.CCErrors != 1 {
+		t.Errorf("unexpected health after a discontinuity: %+v", health)
+	}
+
+}
+
+func TestAnalyzerScrambled(t *testing.T) {
+
+	var a = newTSAnalyzer()
+	a.write(testProgram())
+
+	// Scrambled video packets (even key): the payload is encrypted, no PTS
+	for cc := byte(0); cc < 4; cc++ {
+
+		var p = testPacket(0x100, cc, nil)
+		p[3] |= 0x80
+
+		a.lastVideo = time.Now().Add(-2 * time.Second)
+		a.write(p)
+
+	}
+
+	if health := a.health(time.Second); health.Status != HealthOK || !health.PIDs[2].Scrambled {
+		t.Errorf("scrambled video reported as frozen: %+v", health)
+	}
+
+	// New source without PMT: the video of the last source is not checked
+	a.discontinuity()
+	a.lastVideo = time.Now().Add(-2 * time.Second)
+	a.write(testPacket(0x101, 0, nil))
+
+	if status := a.health(time.Second).Status; status != HealthOK {
+		t.Errorf("expected ok after a discontinuity, got %s", status)
+	}
+
+}
+
+func TestAnalyzerCorrupt(t *testing.T) {
+
+	var a = newTSAnalyzer()
+
+	// Every 10th packet is lost
+	for i := 0; i < 1200; i++ {
+		if i%10 != 9 {
+			a.write(testPacket(0x100, byte(i), nil))
+		}
+	}
+
+	a.windowStart = time.Now().Add(-healthWindow)
+	a.write(testPacket(0x100, 0, nil))
+
+	if status := a.health(time.Minute).Status; status != HealthCorrupt {
+		t.Errorf("expected corrupt stream, got %s", status)
+	}
+
+	a.discontinuity()
+
+	if status := a.health(time.Minute).Status; status != HealthOK {
+		t.Errorf("expected ok after a discontinuity, got %s", status)
+	}
+
+}
@@ -0,0 +1,326 @@
+package buffer
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/storage"
+	"threadfin/internal/structs"
+	"time"
+
+	"github.com/avfs/avfs"
+)
+
+// tsPacketSize : Size of a MPEG-TS packet. Readers that join or fall behind are positioned on a packet boundary.
+const tsPacketSize = 188
+
+// errBroadcasterClosed : The buffer of the stream has been stopped
+var errBroadcasterClosed = errors.New("stream buffer has been closed")
+
+// Broadcaster : Ring buffer of a stream. The buffer (Threadfin, FFmpeg, VLC) writes the data once, every client reads it with its own cursor.
+type Broadcaster struct {
+	mu      sync.Mutex
+	ring    ringStorage
+	size    int64
+	written int64
+	backlog int64
+	notify  chan struct{}
+	closed  bool
+
+	vfs    avfs.VFS
+	folder string
+
+	analyzer *tsAnalyzer
+}
+
+// ringStorage : Storage of the ring buffer, RAM or a file in the buffer filesystem (StoreBufferInRAM = false)
+type ringStorage interface {
+	io.ReaderAt
+	io.WriterAt
+	io.Closer
+}
+
+// memoryRing : Ring buffer in RAM
+type memoryRing []byte
+
+func (m memoryRing) ReadAt(p []byte, off int64) (int, error) {
+	return copy(p, m[off:]), nil
+}
+
+func (m memoryRing) WriteAt(p []byte, off int64) (int, error) {
+	return copy(m[off:], p), nil
+}
+
+func (m memoryRing) Close() error {
+	return nil
+}
+
+// BroadcastReader : Cursor of a client in the ring buffer
+type BroadcastReader struct {
+	broadcaster *Broadcaster
+	pos         int64
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+// NewBroadcaster : Creates the broadcaster for a stream (key: playlistID + MD5) in the buffer folder of the stream, an existing broadcaster with the same key is closed.
+// RAM: the ring buffer holds 10x the buffer size. Disk: the ring buffer is a file in the folder. Both are limited by the max. buffer size per stream.
+// New clients start with one buffer size of data.
+func NewBroadcaster(key, folder string) (b *Broadcaster, err error) {
+
+	var bufferSize = int64(config.Settings.BufferSize) * 1024
+	if bufferSize <= 0 {
+		bufferSize = 1024 * 1024
+	}
+
+	var maxSize = int64(config.Settings.BufferStreamMaxMB) * 1024 * 1024
+	if maxSize <= 0 {
+		maxSize = 64 * 1024 * 1024
+	}
+
+	b = &Broadcaster{
+		backlog:  bufferSize,
+		notify:   make(chan struct{}),
+		vfs:      config.BufferVFS,
+		folder:   folder,
+		analyzer: newTSAnalyzer(),
+	}
+
+	if old, ok := config.BufferBroadcasters.LoadAndDelete(key); ok {
+		_ = old.(*Broadcaster).Close()
+	}
+
+	if err = storage.RemoveVFSFolder(folder, config.System.Folder.Temp, b.vfs); err != nil {
+		return nil, err
+	}
+
+	if err = storage.CheckVFSFolder(folder, b.vfs); err != nil {
+		return nil, err
+	}
+
+	if config.Settings.StoreBufferInRAM {
+
+		b.size = min(bufferSize*10, maxSize)
+		b.ring = make(memoryRing, b.size)
+
+	} else {
+
+		b.size = maxSize
+		b.ring, err = b.vfs.OpenFile(folder+"buffer.ts", os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	b.backlog = min(b.backlog, b.size)
+
+	config.BufferBroadcasters.Store(key, b)
+
+	return
+}
+
+// GetBroadcaster : Returns the broadcaster of a stream (key: playlistID + MD5)
+func GetBroadcaster(key string) (b *Broadcaster, ok bool) {
+
+	if v, found := config.BufferBroadcasters.Load(key); found {
+		b, ok = v.(*Broadcaster)
+	}
+
+	return
+}
+
+// Write : Appends data to the ring buffer and wakes up all waiting readers. Data that is older than the ring size is overwritten.
+func (b *Broadcaster) Write(p []byte) (n int, err error) {
+
+	b.analyzer.write(p)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return 0, errBroadcasterClosed
+	}
+
+	n = len(p)
+
+	// Only the end of very large writes fits into the ring
+	if int64(len(p)) > b.size {
+		b.written += int64(len(p)) - b.size
+		p = p[int64(len(p))-b.size:]
+	}
+
+	for len(p) > 0 {
+
+		var offset = b.written % b.size
+		var chunk = p[:min(int64(len(p)), b.size-offset)]
+
+		c, err := b.ring.WriteAt(chunk, offset)
+		b.written += int64(c)
+		p = p[c:]
+
+		if err != nil {
+			return n - len(p), err
+		}
+
+	}
+
+	close(b.notify)
+	b.notify = make(chan struct{})
+
+	return
+}
+
+// Written : Total number of bytes written into the broadcaster
+func (b *Broadcaster) Written() int64 {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.written
+}
+
+// Health : MPEG-TS metrics of the stream. The video is frozen if it has not progressed within the stall timeout.
+func (b *Broadcaster) Health() structs.StreamHealth {
+	return b.analyzer.health(stallTimeout())
+}
+
+// Close : Stops the broadcaster and removes the buffer folder of the stream, readers receive io.EOF
+func (b *Broadcaster) Close() error {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+
+	b.closed = true
+	close(b.notify)
+
+	if err := b.ring.Close(); err != nil {
+		cli.ShowError(err, 0)
+	}
+
+	if err := storage.RemoveVFSFolder(b.folder, config.System.Folder.Temp, b.vfs); err != nil {
+		cli.ShowError(err, 4005)
+	}
+
+	return nil
+}
+
+// NewReader : Creates a reader that starts one buffer size behind the current write position
+func (b *Broadcaster) NewReader() *BroadcastReader {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return &BroadcastReader{
+		broadcaster: b,
+		pos:         b.startPosition(b.backlog),
+		done:        make(chan struct{}),
+	}
+}
+
+// startPosition : Position behind the write position (max. the size of the ring), aligned to a MPEG-TS packet
+func (b *Broadcaster) startPosition(backlog int64) (pos int64) {
+
+	if backlog > b.size {
+		backlog = b.size
+	}
+
+	pos = b.written - backlog
+	if pos <= 0 {
+		return 0
+	}
+
+	if rest := pos % tsPacketSize; rest != 0 {
+		pos += tsPacketSize - rest
+	}
+
+	return
+}
+
+// Read : Blocks until data is available (io.Reader)
+func (r *BroadcastReader) Read(p []byte) (n int, err error) {
+	return r.ReadTimeout(p, 0)
+}
+
+// ReadTimeout : Waits max. timeout (0 = no timeout) for new data, returns 0 bytes without error if no data has been written in the meantime.
+// A reader that is too slow skips the data that has already been overwritten.
+func (r *BroadcastReader) ReadTimeout(p []byte, timeout time.Duration) (n int, err error) {
+
+	var b = r.broadcaster
+	var timer <-chan time.Time
+
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		timer = t.C
+	}
+
+	for {
+
+		b.mu.Lock()
+
+		if b.closed {
+			b.mu.Unlock()
+			return 0, io.EOF
+		}
+
+		if b.written-r.pos > b.size {
+			r.pos = b.startPosition(b.backlog)
+		}
+
+		if r.pos < b.written {
+
+			var available = b.written - r.pos
+			if int64(len(p)) > available {
+				p = p[:available]
+			}
+
+			for n < len(p) {
+
+				var offset = (r.pos + int64(n)) % b.size
+				var chunk = p[n:min(int64(len(p)), int64(n)+b.size-offset)]
+
+				c, readErr := b.ring.ReadAt(chunk, offset)
+				n += c
+
+				if readErr != nil && c < len(chunk) {
+					err = readErr
+					break
+				}
+
+			}
+
+			r.pos += int64(n)
+			b.mu.Unlock()
+			return
+		}
+
+		var notify = b.notify
+		b.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-r.done:
+			return 0, io.EOF
+		case <-timer:
+			return 0, nil
+		}
+
+	}
+
+}
+
+// Close : Stops the reader, a blocked Read returns io.EOF
+func (r *BroadcastReader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+	return nil
+}
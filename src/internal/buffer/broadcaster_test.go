@@ -0,0 +1,218 @@
+package buffer
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+	"testing"
+	"threadfin/internal/config"
+	"time"
+
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/avfs/avfs/vfs/osfs"
+)
+
+func newTestBroadcaster(t *testing.T) *Broadcaster {
+
+	config.Settings.BufferSize = 1 // 1 KB: ring 10 KB, backlog 1 KB
+	config.Settings.BufferStreamMaxMB = 64
+	config.Settings.StoreBufferInRAM = true
+	config.System.Folder.Temp = t.TempDir() + string(os.PathSeparator)
+	config.BufferVFS = memfs.New()
+
+	var key = "M-test-" + t.Name()
+
+	b, err := NewBroadcaster(key, testBufferFolder())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		config.BufferBroadcasters.Delete(key)
+	})
+
+	return b
+}
+
+func testBufferFolder() string {
+	return config.System.Folder.Temp + "M123" + string(os.PathSeparator) + "md5" + string(os.PathSeparator)
+}
+
+func packets(n int, start byte) []byte {
+
+	var data = make([]byte, 0, n*tsPacketSize)
+	for i := 0; i < n; i++ {
+		data = append(data, bytes.Repeat([]byte{start + byte(i)}, tsPacketSize)...)
+	}
+
+	return data
+}
+
+func TestBroadcasterFanOut(t *testing.T) {
+
+	var b = newTestBroadcaster(t)
+	var data = packets(40, 0)
+	var wg sync.WaitGroup
+	var results = make([][]byte, 3)
+
+	var readers = make([]*BroadcastReader, len(results))
+	for i := range readers {
+		readers[i] = b.NewReader()
+	}
+
+	for i, r := range readers {
+		wg.Add(1)
+		go func(i int, r *BroadcastReader) {
+			defer wg.Done()
+			results[i], _ = io.ReadAll(r)
+		}(i, r)
+	}
+
+	// Write in chunks that are smaller than the ring, the readers keep up
+	for i := 0; i < len(data); i += 1000 {
+		var end = i + 1000
+		if end > len(data) {
+			end = len(data)
+		}
+		if _, err := b.Write(data[i:end]); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	_ = b.Close()
+	wg.Wait()
+
+	for i, result := range results {
+		if !bytes.Equal(result, data) {
+			t.Errorf("reader %d: received %d bytes, expected %d", i, len(result), len(data))
+		}
+	}
+
+	if _, err := b.Write(data); err != errBroadcasterClosed {
+		t.Errorf("expected errBroadcasterClosed, got %v", err)
+	}
+
+}
+
+func TestBroadcasterSlowReader(t *testing.T) {
+
+	var b = newTestBroadcaster(t)
+	var r = b.NewReader()
+
+	// 100 packets (18.8 KB) overwrite the 10 KB ring, the reader continues with the last buffer size
+	var data = packets(100, 0)
+	if _, err := b.Write(data); err != nil {
+		t.Fatal(err)
+	}
+
+	var p = make([]byte, len(data))
+	n, err := r.ReadTimeout(p, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n == 0 || n > 1024+tsPacketSize || n%tsPacketSize != 0 {
+		t.Fatalf("unexpected number of bytes after overrun: %d", n)
+	}
+
+	if !bytes.Equal(p[:n], data[len(data)-n:]) {
+		t.Error("reader did not continue with the newest data")
+	}
+
+	// No new data: timeout without error
+	n, err = r.ReadTimeout(p, 10*time.Millisecond)
+	if n != 0 || err != nil {
+		t.Errorf("expected timeout, got n=%d err=%v", n, err)
+	}
+
+	_ = r.Close()
+	if _, err = r.Read(p); err != io.EOF {
+		t.Errorf("expected io.EOF after Close, got %v", err)
+	}
+
+}
+
+func TestBroadcasterReplace(t *testing.T) {
+
+	var old = newTestBroadcaster(t)
+	var key = "M-test-" + t.Name()
+
+	b, err := NewBroadcaster(key, testBufferFolder())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := old.Write([]byte{0}); err != errBroadcasterClosed {
+		t.Error("replaced broadcaster has not been closed")
+	}
+
+	if current, ok := GetBroadcaster(key); !ok || current != b {
+		t.Error("GetBroadcaster does not return the new broadcaster")
+	}
+
+}
+
+func TestBroadcasterDisk(t *testing.T) {
+
+	config.Settings.BufferSize = 1
+	config.Settings.BufferStreamMaxMB = 1
+	config.Settings.StoreBufferInRAM = false
+	config.System.Folder.Temp = t.TempDir() + string(os.PathSeparator)
+	config.BufferVFS = osfs.NewWithNoIdm()
+
+	var key = "M-test-" + t.Name()
+	var folder = testBufferFolder()
+
+	b, err := NewBroadcaster(key, folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer config.BufferBroadcasters.Delete(key)
+
+	var r = b.NewReader()
+
+	// 2 MB through a 1 MB ring file: the file does not grow beyond the limit
+	var data = packets(200, 0)
+	for i := 0; i < 56; i++ {
+		if _, err = b.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	info, err := os.Stat(folder + "buffer.ts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Size() != 1024*1024 {
+		t.Errorf("unexpected size of the buffer file: %d", info.Size())
+	}
+
+	// The reader has been overrun and continues with the last buffer size (1 KB)
+	var p = make([]byte, 4096)
+	n, err := r.ReadTimeout(p, time.Second)
+	if err != nil || n == 0 || n > 1024 {
+		t.Fatalf("unexpected read after overrun: n=%d err=%v", n, err)
+	}
+
+	// The data at position x of the stream is data[x % len(data)]
+	var start = b.Written() - int64(n)
+	for i := 0; i < n; i++ {
+		if p[i] != data[(start+int64(i))%int64(len(data))] {
+			t.Fatalf("reader did not continue with the newest data (byte %d)", i)
+		}
+	}
+
+	_ = b.Close()
+
+	if _, err = os.Stat(folder); !os.IsNotExist(err) {
+		t.Errorf("buffer folder has not been removed: %v", err)
+	}
+
+	if _, err = os.Stat(config.System.Folder.Temp); err != nil {
+		t.Errorf("temporary folder has been removed: %v", err)
+	}
+
+}
@@ -1,16 +1,104 @@
 package buffer
 
-/*
-  Render tuner-limit image as video [ffmpeg]
-  -loop 1 -i stream-limit.jpg -c:v libx264 -t 1 -pix_fmt yuv420p -vf scale=1920:1080  stream-limit.ts
-*/
-
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"threadfin/internal/cli"
 	"threadfin/internal/config"
+	"threadfin/internal/storage"
+	"threadfin/internal/structs"
 
 	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/avfs/avfs/vfs/osfs"
 )
 
+// Buffer : Buffer type of a provider. Run writes the stream into the broadcaster until the stream ends, the clients disconnect or ctx is canceled (watchdog).
+type Buffer interface {
+	Run(ctx context.Context, streamID int, playlistID string, streamURL string, broadcaster *Broadcaster) error
+}
+
+// BufferFunc : Adapter to use a function as Buffer
+type BufferFunc func(ctx context.Context, streamID int, playlistID string, streamURL string, broadcaster *Broadcaster) error
+
+// Run : Calls f
+func (f BufferFunc) Run(ctx context.Context, streamID int, playlistID string, streamURL string, broadcaster *Broadcaster) error {
+	return f(ctx, streamID, playlistID, streamURL, broadcaster)
+}
+
+// bufferTypes : Available buffer types (value "buffer" of the settings and the provider, "-" = no buffer)
+var bufferTypes = map[string]Buffer{
+	"threadfin": BufferFunc(Threadfin),
+	"ffmpeg":    BufferFunc(ThirdParty),
+	"vlc":       BufferFunc(ThirdParty),
+	"command":   BufferFunc(Command),
+}
+
+// IsBufferType : Checks if a buffer type exists
+func IsBufferType(bufferType string) bool {
+	_, ok := bufferTypes[bufferType]
+	return ok
+}
+
+// InitVFS : Filesystem for the buffer. RAM (memfs) or the temporary folder on the disk (osfs), see StoreBufferInRAM
 func InitVFS() {
-	config.BufferVFS = memfs.New()
+
+	if config.Settings.StoreBufferInRAM {
+		config.BufferVFS = memfs.New()
+		return
+	}
+
+	config.BufferVFS = osfs.NewWithNoIdm()
+	cli.ShowInfo(fmt.Sprintf("Buffer:Store buffer on disk (%s)", storage.GetPlatformPath(config.System.Folder.Temp)))
+
+	removeUnusedBufferFolders()
+}
+
+// removeUnusedBufferFolders : Removes buffer folders of playlists (e.g. from a crash) that are not streaming anymore
+func removeUnusedBufferFolders() {
+
+	var tmpFolder = config.System.Folder.Temp
+
+	files, err := config.BufferVFS.ReadDir(storage.GetPlatformPath(tmpFolder))
+	if err != nil {
+		return
+	}
+
+	for _, file := range files {
+
+		var playlistID = file.Name()
+
+		if !file.IsDir() || !(strings.HasPrefix(playlistID, "M") || strings.HasPrefix(playlistID, "H")) {
+			continue
+		}
+
+		if _, ok := config.BufferInformation.Load(playlistID); ok {
+			continue
+		}
+
+		var folder = tmpFolder + playlistID + string(os.PathSeparator)
+
+		cli.ShowDebug(fmt.Sprintf("Buffer:Remove unused buffer folder (%s)", folder), 1)
+
+		if err = storage.RemoveVFSFolder(folder, tmpFolder, config.BufferVFS); err != nil {
+			cli.ShowError(err, 4005)
+		}
+
+	}
+
+}
+
+// getStreamURLs : Streaming URL of the channel followed by the URLs of the backup channels (in the order of the list)
+func getStreamURLs(stream structs.ThisStream) (urls []string) {
+
+	urls = append(urls, stream.URL)
+
+	for _, backup := range stream.BackupChannels {
+		if len(backup.URL) > 0 {
+			urls = append(urls, backup.URL)
+		}
+	}
+
+	return
 }
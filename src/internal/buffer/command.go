@@ -0,0 +1,105 @@
+package buffer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/storage"
+	"threadfin/internal/structs"
+)
+
+// Command : Buffer with any executable (e.g. streamlink, yt-dlp or a script) that writes MPEG-TS to stdout.
+// Placeholders in the options: [URL], [USERAGENT], [REFERER], [PROXY]
+func Command(ctx context.Context, streamID int, playlistID string, streamURL string, broadcaster *Broadcaster) (err error) {
+
+	p, ok := config.BufferInformation.Load(playlistID)
+	if !ok {
+		return errClientDisconnected
+	}
+
+	var playlist = p.(structs.Playlist)
+	var profile = getBufferProfile(playlist, playlist.Streams[streamID])
+
+	err = storage.CheckFile(profile.Path)
+	if err != nil {
+		return
+	}
+
+	cli.ShowInfo(fmt.Sprintf("COMMAND path:%s", profile.Path))
+	if len(profile.Name) > 0 {
+		cli.ShowInfo(fmt.Sprintf("COMMAND profile:%s", profile.Name))
+	}
+	cli.ShowInfo("Streaming URL:" + streamURL)
+
+	var args = commandArgs(profile.Options, commandPlaceholders(playlist, profile, streamURL))
+
+	return runProcess(ctx, "COMMAND", profile.Path, args, streamID, playlistID, broadcaster)
+}
+
+// commandPlaceholders : Values of the placeholders. User-Agent and Referer of the buffer profile are used before the settings and the provider.
+func commandPlaceholders(playlist structs.Playlist, profile structs.BufferProfile, streamURL string) map[string]string {
+
+	var values = map[string]string{
+		"[URL]":       streamURL,
+		"[USERAGENT]": config.Settings.UserAgent,
+		"[REFERER]":   playlist.HttpUserReferer,
+		"[PROXY]":     "",
+	}
+
+	if playlist.HttpProxyIP != "" && playlist.HttpProxyPort != "" {
+		values["[PROXY]"] = fmt.Sprintf("http://%s:%s", playlist.HttpProxyIP, playlist.HttpProxyPort)
+	}
+
+	for _, header := range profile.Headers {
+
+		name, value, _ := strings.Cut(header, ":")
+
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "user-agent":
+			values["[USERAGENT]"] = strings.TrimSpace(value)
+		case "referer":
+			values["[REFERER]"] = strings.TrimSpace(value)
+		}
+
+	}
+
+	return values
+}
+
+// commandArgs : Replaces the placeholders in the options. An argument with an empty placeholder is omitted together with its option,
+// e.g. "--http-proxy [PROXY]" without a proxy.
+func commandArgs(options string, values map[string]string) (args []string) {
+
+	for _, a := range strings.Fields(options) {
+
+		var empty = false
+
+		for placeholder, value := range values {
+
+			if !strings.Contains(a, placeholder) {
+				continue
+			}
+
+			if len(value) == 0 {
+				empty = true
+			}
+
+			a = strings.ReplaceAll(a, placeholder, value)
+		}
+
+		if empty {
+
+			if !strings.HasPrefix(a, "-") && len(args) > 0 && strings.HasPrefix(args[len(args)-1], "-") {
+				args = args[:len(args)-1]
+			}
+
+			continue
+		}
+
+		args = append(args, a)
+	}
+
+	return
+}
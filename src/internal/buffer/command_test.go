@@ -0,0 +1,43 @@
+package buffer
+
+import (
+	"reflect"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+)
+
+func TestCommandArgs(t *testing.T) {
+
+	config.Settings.UserAgent = "Threadfin"
+
+	var options = "--stdout --http-header User-Agent=[USERAGENT] --http-header Referer=[REFERER] --http-proxy [PROXY] [URL] best"
+	var playlist = structs.Playlist{HttpUserReferer: "http://example.com/"}
+	var profile = structs.BufferProfile{Headers: []string{"User-Agent: Mozilla/5.0"}}
+
+	var args = commandArgs(options, commandPlaceholders(playlist, profile, "https://www.twitch.tv/channel"))
+	var expected = []string{"--stdout", "--http-header", "User-Agent=Mozilla/5.0", "--http-header", "Referer=http://example.com/", "https://www.twitch.tv/channel", "best"}
+
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("unexpected arguments:\n%q\nexpected:\n%q", args, expected)
+	}
+
+	// Without referer, the header option is omitted. The proxy is set.
+	playlist = structs.Playlist{HttpProxyIP: "10.0.0.1", HttpProxyPort: "3128"}
+
+	args = commandArgs(options, commandPlaceholders(playlist, structs.BufferProfile{}, "https://www.youtube.com/live"))
+	expected = []string{"--stdout", "--http-header", "User-Agent=Threadfin", "--http-proxy", "http://10.0.0.1:3128", "https://www.youtube.com/live", "best"}
+
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("unexpected arguments:\n%q\nexpected:\n%q", args, expected)
+	}
+
+	// Option and value in one argument
+	args = commandArgs("-o - --proxy=[PROXY] [URL]", commandPlaceholders(structs.Playlist{}, structs.BufferProfile{}, "http://a/1"))
+	expected = []string{"-o", "-", "http://a/1"}
+
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("unexpected arguments:\n%q\nexpected:\n%q", args, expected)
+	}
+
+}
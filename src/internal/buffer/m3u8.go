@@ -0,0 +1,219 @@
+package buffer
+
+import (
+	"errors"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"threadfin/internal/cli"
+	"threadfin/internal/structs"
+)
+
+// parseM3U8 : Parses a HLS playlist into the HLS fields of the stream. master is true if the playlist only contains variants (#EXT-X-STREAM-INF).
+func parseM3U8(stream *structs.ThisStream, body string, base *url.URL) (master bool, err error) {
+
+	var lines = strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+	var dynamicStreams = make(map[int]structs.DynamicStream)
+	var segment structs.Segment
+	var sequence int64
+	var streamInf = false
+
+	if len(lines) == 0 || !strings.HasPrefix(strings.TrimSpace(lines[0]), "#EXTM3U") {
+		err = errors.New(cli.GetErrMsg(4051))
+		return
+	}
+
+	stream.Body = body
+	stream.Segment = make([]structs.Segment, 0)
+	stream.HLS = true
+
+	for _, line := range lines[1:] {
+
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		switch {
+
+		case strings.HasPrefix(line, "#EXT-X-VERSION:"):
+			stream.Version, _ = strconv.Atoi(strings.TrimPrefix(line, "#EXT-X-VERSION:"))
+
+		case strings.HasPrefix(line, "#EXT-X-TARGETDURATION:"):
+			stream.TimeSegDuration, err = strconv.ParseFloat(strings.TrimPrefix(line, "#EXT-X-TARGETDURATION:"), 64)
+			if err != nil {
+				err = errors.New(cli.GetErrMsg(1050))
+				return
+			}
+
+		case strings.HasPrefix(line, "#EXT-X-MEDIA-SEQUENCE:"):
+			sequence, err = strconv.ParseInt(strings.TrimPrefix(line, "#EXT-X-MEDIA-SEQUENCE:"), 10, 64)
+			if err != nil {
+				err = errors.New(cli.GetErrMsg(4050))
+				return
+			}
+			stream.FirstSequence = sequence
+
+		case strings.HasPrefix(line, "#EXT-X-PLAYLIST-TYPE:"):
+			segment.PlaylistType = strings.TrimPrefix(line, "#EXT-X-PLAYLIST-TYPE:")
+
+		case strings.HasPrefix(line, "#EXT-X-STREAM-INF:"):
+			streamInf = true
+			segment = structs.Segment{}
+			segment.Info = true
+
+			for key, value := range parseM3U8Attributes(strings.TrimPrefix(line, "#EXT-X-STREAM-INF:")) {
+
+				switch key {
+				case "BANDWIDTH":
+					segment.StreamInf.Bandwidth, _ = strconv.Atoi(value)
+				case "AVERAGE-BANDWIDTH":
+					segment.StreamInf.AverageBandwidth, _ = strconv.Atoi(value)
+				case "FRAME-RATE":
+					segment.StreamInf.Framerate, _ = strconv.ParseFloat(value, 64)
+				case "RESOLUTION":
+					segment.StreamInf.Resolution = value
+				}
+
+			}
+
+		case strings.HasPrefix(line, "#EXTINF:"):
+			var duration = strings.SplitN(strings.TrimPrefix(line, "#EXTINF:"), ",", 2)[0]
+			segment.Duration, err = strconv.ParseFloat(duration, 64)
+			if err != nil {
+				err = errors.New(cli.GetErrMsg(1050))
+				return
+			}
+
+		case strings.HasPrefix(line, "#"):
+			continue
+
+		default:
+			// URL of a variant or a media segment
+			var segmentURL = resolveM3U8URL(base, line)
+
+			if streamInf {
+				segment.StreamInf.SegmentURL = segmentURL
+
+				dynamicStreams[len(dynamicStreams)] = structs.DynamicStream{
+					AverageBandwidth: segment.StreamInf.AverageBandwidth,
+					Bandwidth:        segment.StreamInf.Bandwidth,
+					Framerate:        segment.StreamInf.Framerate,
+					Resolution:       segment.StreamInf.Resolution,
+					URL:              segmentURL,
+				}
+
+				streamInf = false
+			} else {
+				segment.URL = segmentURL
+				segment.Sequence = sequence
+				segment.Version = stream.Version
+				sequence++
+			}
+
+			stream.Segment = append(stream.Segment, segment)
+			segment = structs.Segment{PlaylistType: segment.PlaylistType}
+
+		}
+
+	}
+
+	if len(stream.Segment) == 0 {
+		err = errors.New(cli.GetErrMsg(4050))
+		return
+	}
+
+	if len(dynamicStreams) > 0 {
+		stream.DynamicStream = dynamicStreams
+		return true, nil
+	}
+
+	stream.LastSequence = stream.Segment[len(stream.Segment)-1].Sequence
+
+	return
+}
+
+// parseM3U8Attributes : Splits an attribute list (KEY=VALUE,KEY="VALUE") into a map
+func parseM3U8Attributes(list string) (attributes map[string]string) {
+
+	attributes = make(map[string]string)
+
+	var key, value strings.Builder
+	var inKey = true
+	var quoted = false
+
+	var store = func() {
+		if key.Len() > 0 {
+			attributes[strings.TrimSpace(key.String())] = strings.Trim(value.String(), `"`)
+		}
+		key.Reset()
+		value.Reset()
+		inKey = true
+	}
+
+	for _, r := range list {
+
+		switch {
+		case r == '"':
+			quoted = !quoted
+			value.WriteRune(r)
+		case r == '=' && inKey:
+			inKey = false
+		case r == ',' && !quoted:
+			store()
+		case inKey:
+			key.WriteRune(r)
+		default:
+			value.WriteRune(r)
+		}
+
+	}
+
+	store()
+
+	return
+}
+
+// resolveM3U8URL : Resolves relative segment and variant URLs against the playlist URL
+func resolveM3U8URL(base *url.URL, reference string) string {
+
+	if base == nil {
+		return reference
+	}
+
+	u, err := url.Parse(reference)
+	if err != nil {
+		return reference
+	}
+
+	return base.ResolveReference(u).String()
+}
+
+// selectDynamicStream : Picks the variant with the highest bandwidth that does not exceed the limit (bit/s).
+// If no variant fits, the variant with the lowest bandwidth is used.
+func selectDynamicStream(dynamicStreams map[int]structs.DynamicStream, limit int) (selected structs.DynamicStream) {
+
+	var variants = make([]structs.DynamicStream, 0, len(dynamicStreams))
+	for _, v := range dynamicStreams {
+		variants = append(variants, v)
+	}
+
+	if len(variants) == 0 {
+		return
+	}
+
+	sort.Slice(variants, func(i, j int) bool {
+		return variants[i].Bandwidth < variants[j].Bandwidth
+	})
+
+	selected = variants[0]
+
+	for _, v := range variants {
+		if limit <= 0 || v.Bandwidth <= limit {
+			selected = v
+		}
+	}
+
+	return
+}
@@ -0,0 +1,71 @@
+package buffer
+
+import (
+	"net/url"
+	"testing"
+	"threadfin/internal/structs"
+)
+
+func TestParseM3U8Master(t *testing.T) {
+
+	var body = `#EXTM3U
+#EXT-X-STREAM-INF:BANDWIDTH=800000,RESOLUTION=640x360,CODECS="avc1.4d401e,mp4a.40.2"
+low/index.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=5000000,RESOLUTION=1920x1080
+https://cdn.example.com/high/index.m3u8
+`
+
+	var stream structs.ThisStream
+	base, _ := url.Parse("http://example.com/live/master.m3u8")
+
+	master, err := parseM3U8(&stream, body, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !master || len(stream.DynamicStream) != 2 {
+		t.Fatalf("expected master playlist with 2 variants, got master=%t variants=%d", master, len(stream.DynamicStream))
+	}
+
+	var variant = selectDynamicStream(stream.DynamicStream, 1000000)
+	if variant.URL != "http://example.com/live/low/index.m3u8" || variant.Resolution != "640x360" {
+		t.Errorf("unexpected variant: %+v", variant)
+	}
+
+	variant = selectDynamicStream(stream.DynamicStream, 0)
+	if variant.URL != "https://cdn.example.com/high/index.m3u8" {
+		t.Errorf("unexpected variant: %+v", variant)
+	}
+
+}
+
+func TestParseM3U8Media(t *testing.T) {
+
+	var body = "#EXTM3U\r\n#EXT-X-VERSION:3\r\n#EXT-X-TARGETDURATION:6\r\n#EXT-X-MEDIA-SEQUENCE:100\r\n" +
+		"#EXTINF:6.000,\r\nseg100.ts\r\n#EXTINF:5.5,title\r\nseg101.ts\r\n"
+
+	var stream structs.ThisStream
+	base, _ := url.Parse("http://example.com/live/chunks.m3u8?token=1")
+
+	master, err := parseM3U8(&stream, body, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if master {
+		t.Fatal("media playlist detected as master playlist")
+	}
+
+	if stream.TimeSegDuration != 6 || stream.FirstSequence != 100 || stream.LastSequence != 101 || stream.Version != 3 {
+		t.Errorf("unexpected playlist values: duration=%v first=%d last=%d version=%d", stream.TimeSegDuration, stream.FirstSequence, stream.LastSequence, stream.Version)
+	}
+
+	if len(stream.Segment) != 2 || stream.Segment[1].URL != "http://example.com/live/seg101.ts" || stream.Segment[1].Duration != 5.5 {
+		t.Errorf("unexpected segments: %+v", stream.Segment)
+	}
+
+	if _, err = parseM3U8(&stream, "<html></html>", base); err == nil {
+		t.Error("expected error for invalid playlist")
+	}
+
+}
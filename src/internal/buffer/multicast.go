@@ -0,0 +1,189 @@
+package buffer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"threadfin/internal/cli"
+	"threadfin/internal/client"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+	"time"
+
+	"golang.org/x/net/ipv4"
+)
+
+// isMulticastURL : UDP / RTP streams (udp://@239.0.0.1:1234, rtp://@239.0.0.1:1234) are received by Threadfin itself
+func isMulticastURL(streamURL string) bool {
+	return strings.HasPrefix(streamURL, "udp://") || strings.HasPrefix(streamURL, "rtp://")
+}
+
+// parseMulticastURL : Group and port of the stream. Source-specific multicast: udp://10.0.0.1@239.0.0.1:1234.
+// Without a group (udp://@:1234), the datagrams that are sent to the port of this host are received.
+func parseMulticastURL(streamURL string) (group *net.UDPAddr, source net.IP, err error) {
+
+	u, err := url.Parse(streamURL)
+	if err != nil {
+		return
+	}
+
+	group, err = net.ResolveUDPAddr("udp4", u.Host)
+	if err != nil {
+		return
+	}
+
+	if group.Port == 0 {
+		err = fmt.Errorf("no port: %s", streamURL)
+		return
+	}
+
+	if group.IP != nil && !group.IP.IsUnspecified() && !group.IP.IsMulticast() {
+		err = fmt.Errorf("no multicast address: %s", streamURL)
+		return
+	}
+
+	if u.User != nil && len(u.User.Username()) > 0 {
+
+		source = net.ParseIP(u.User.Username()).To4()
+		if source == nil {
+			err = fmt.Errorf("invalid source address: %s", streamURL)
+			return
+		}
+
+	}
+
+	return
+}
+
+// joinMulticast : Joins the multicast group on the interface from the settings (empty: interface of the system)
+func joinMulticast(group *net.UDPAddr, source net.IP) (conn net.PacketConn, err error) {
+
+	var ifi *net.Interface
+
+	if len(config.Settings.UDPInterface) > 0 {
+		ifi, err = net.InterfaceByName(config.Settings.UDPInterface)
+		if err != nil {
+			return
+		}
+	}
+
+	conn, err = net.ListenPacket("udp4", group.String())
+	if err != nil {
+		return
+	}
+
+	if group.IP == nil || group.IP.IsUnspecified() {
+		return
+	}
+
+	var p = ipv4.NewPacketConn(conn)
+
+	if source != nil {
+		err = p.JoinSourceSpecificGroup(ifi, &net.UDPAddr{IP: group.IP}, &net.UDPAddr{IP: source})
+	} else {
+		err = p.JoinGroup(ifi, &net.UDPAddr{IP: group.IP})
+	}
+
+	if err != nil {
+		_ = conn.Close()
+	}
+
+	return
+}
+
+// bufferMulticast : Receives the MPEG-TS datagrams (raw UDP or RTP) of the multicast group, the data is split into segments of half the buffer size
+func bufferMulticast(ctx context.Context, stream *structs.ThisStream, streamURL string, segments *segmentWriter) (err error) {
+
+	group, source, err := parseMulticastURL(streamURL)
+	if err != nil {
+		return
+	}
+
+	conn, err := joinMulticast(group, source)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	cli.ShowInfo(fmt.Sprintf("Streaming Status:Receive data from THREADFIN (Multicast %s)", group.String()))
+
+	var bufferSize = config.Settings.BufferSize * 1024
+	var buf bytes.Buffer
+	var datagram = make([]byte, 65536)
+
+	for {
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// The deadline lets the loop check the context and the clients if no data is received
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+
+		n, _, readErr := conn.ReadFrom(datagram)
+		if readErr != nil && !errors.Is(readErr, os.ErrDeadlineExceeded) {
+			return readErr
+		}
+
+		buf.Write(rtpPayload(datagram[:n]))
+
+		if buf.Len() >= bufferSize/2 {
+
+			err = segments.write(buf.Bytes())
+			if err != nil {
+				return
+			}
+
+			buf.Reset()
+
+		}
+
+		if !client.Connection(*stream) {
+			return errClientDisconnected
+		}
+
+	}
+
+}
+
+// rtpPayload : MPEG-TS data of a datagram. RTP (version 2) header, CSRCs, extension and padding are removed, raw UDP (0x47) is returned unchanged.
+func rtpPayload(datagram []byte) []byte {
+
+	if len(datagram) < 12 || datagram[0] == 0x47 || datagram[0]>>6 != 2 {
+		return datagram
+	}
+
+	var header = 12 + 4*int(datagram[0]&0x0f)
+
+	// Extension
+	if datagram[0]&0x10 != 0 {
+
+		if len(datagram) < header+4 {
+			return nil
+		}
+
+		header += 4 + 4*int(binary.BigEndian.Uint16(datagram[header+2:]))
+	}
+
+	var end = len(datagram)
+
+	// Padding
+	if datagram[0]&0x20 != 0 {
+		end -= int(datagram[end-1])
+	}
+
+	if header > end {
+		return nil
+	}
+
+	return datagram[header:end]
+}
@@ -0,0 +1,127 @@
+package buffer
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+	"time"
+
+	"golang.org/x/net/ipv4"
+)
+
+func TestParseMulticastURL(t *testing.T) {
+
+	group, source, err := parseMulticastURL("udp://@239.1.2.3:5000")
+	if err != nil || group.String() != "239.1.2.3:5000" || source != nil {
+		t.Errorf("unexpected result: %v %v %v", group, source, err)
+	}
+
+	group, source, err = parseMulticastURL("rtp://10.0.0.1@232.1.1.1:1234")
+	if err != nil || group.String() != "232.1.1.1:1234" || !source.Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("unexpected result: %v %v %v", group, source, err)
+	}
+
+	group, _, err = parseMulticastURL("udp://@:1234")
+	if err != nil || group.Port != 1234 {
+		t.Errorf("unexpected result: %v %v", group, err)
+	}
+
+	for _, u := range []string{"udp://@239.1.2.3", "udp://@192.168.1.1:1234", "udp://x@239.1.2.3:1234"} {
+		if _, _, err = parseMulticastURL(u); err == nil {
+			t.Errorf("expected error for %s", u)
+		}
+	}
+
+}
+
+func TestRTPPayload(t *testing.T) {
+
+	var ts = packets(7, 0)
+	ts[0] = 0x47
+
+	if !bytes.Equal(rtpPayload(ts), ts) {
+		t.Error("raw UDP datagram has been changed")
+	}
+
+	// RTP header with 1 CSRC, extension (1 word) and 4 bytes padding
+	var datagram = []byte{0x80 | 0x20 | 0x10 | 0x01, 33, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0}
+	datagram = append(datagram, 0, 0, 0, 0) // CSRC
+	datagram = append(datagram, 0, 0, 0, 1) // Extension header
+	datagram = append(datagram, 0, 0, 0, 0) // Extension
+	datagram = append(datagram, ts...)
+	datagram = append(datagram, 0, 0, 0, 4) // Padding
+
+	if !bytes.Equal(rtpPayload(datagram), ts) {
+		t.Error("unexpected RTP payload")
+	}
+
+}
+
+func TestBufferMulticast(t *testing.T) {
+
+	config.Settings.BufferSize = 1 // Segments of 512 bytes
+	config.Settings.UDPInterface = "lo"
+
+	var b = newTestBroadcaster(t)
+	var r = b.NewReader()
+	var segments = &segmentWriter{broadcaster: b, playlistID: "M-test", streamID: 0}
+	var stream = structs.ThisStream{PlaylistID: "M-test", MD5: "md5"}
+
+	config.BufferClients.Store(stream.PlaylistID+stream.MD5, structs.ClientConnection{Connection: 1})
+	defer config.BufferClients.Delete(stream.PlaylistID + stream.MD5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var group = &net.UDPAddr{IP: net.IPv4(239, 255, 42, 42), Port: 45678}
+	var done = make(chan error, 1)
+
+	go func() {
+		done <- bufferMulticast(ctx, &stream, "udp://@"+group.String(), segments)
+	}()
+
+	// Local multicast sender on the loopback interface
+	ifi, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer conn.Close()
+
+	var sender = ipv4.NewPacketConn(conn)
+	_ = sender.SetMulticastInterface(ifi)
+	_ = sender.SetMulticastLoopback(true)
+
+	var data = packets(7, 0)
+	var received = make([]byte, len(data))
+
+	for i := 0; i < 50; i++ {
+
+		select {
+		case err = <-done:
+			t.Skipf("multicast is not available: %v", err)
+		default:
+		}
+
+		_, _ = sender.WriteTo(data, nil, group)
+
+		if n, _ := r.ReadTimeout(received, 100*time.Millisecond); n > 0 {
+			if !bytes.Equal(received[:n], data[:n]) {
+				t.Fatal("unexpected data from the multicast group")
+			}
+			cancel()
+			<-done
+			return
+		}
+
+	}
+
+	t.Skip("no multicast data received on the loopback interface")
+}
@@ -0,0 +1,571 @@
+package buffer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"threadfin/internal/cli"
+	"threadfin/internal/client"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+	"time"
+)
+
+// rtspMaxBody : Max. Content-Length of an RTSP response (SDP of DESCRIBE)
+const rtspMaxBody = 64 * 1024
+
+// isRTSPURL : RTSP streams (IP cameras, SAT>IP) are received by Threadfin itself, RTP over TCP (interleaved)
+func isRTSPURL(streamURL string) bool {
+	return strings.HasPrefix(streamURL, "rtsp://")
+}
+
+// rtspClient : RTSP session over TCP. The RTP packets are interleaved in the RTSP connection (RFC 2326, 10.12).
+type rtspClient struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	mu      sync.Mutex
+	url     string
+	user    *url.Userinfo
+	cseq    int
+	session string
+	timeout time.Duration
+	auth    string
+}
+
+// rtspResponse : Status, header and body of a RTSP response
+type rtspResponse struct {
+	status int
+	header textproto.MIMEHeader
+	body   []byte
+}
+
+// dialRTSP : Connects to the RTSP server, credentials of the URL are used for Basic / Digest authentication
+func dialRTSP(ctx context.Context, streamURL string) (c *rtspClient, err error) {
+
+	u, err := url.Parse(streamURL)
+	if err != nil {
+		return
+	}
+
+	var host = u.Host
+	if len(u.Port()) == 0 {
+		host = net.JoinHostPort(u.Hostname(), "554")
+	}
+
+	var dialer = net.Dialer{Timeout: 20 * time.Second}
+
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return
+	}
+
+	c = &rtspClient{
+		conn:    conn,
+		reader:  bufio.NewReaderSize(conn, 64*1024),
+		user:    u.User,
+		timeout: 60 * time.Second,
+	}
+
+	u.User = nil
+	c.url = u.String()
+
+	return
+}
+
+// request : Sends a RTSP request and returns the response. A 401 response is answered once with the credentials of the URL.
+func (c *rtspClient) request(method, uri string, header map[string]string) (resp *rtspResponse, err error) {
+
+	for attempt := 0; attempt < 2; attempt++ {
+
+		err = c.send(method, uri, header)
+		if err != nil {
+			return
+		}
+
+		resp, err = c.readResponse()
+		if err != nil {
+			return
+		}
+
+		if resp.status != 401 || c.user == nil || attempt > 0 {
+			break
+		}
+
+		c.auth = selectAuthenticate(resp.header.Values("Www-Authenticate"))
+		if len(c.auth) == 0 {
+			break
+		}
+
+	}
+
+	if resp.status != 200 {
+		err = fmt.Errorf("RTSP %s: %d %s", method, resp.status, uri)
+	}
+
+	return
+}
+
+// send : Writes a RTSP request into the connection (also used by the keep-alive)
+func (c *rtspClient) send(method, uri string, header map[string]string) (err error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cseq++
+
+	var req bytes.Buffer
+	fmt.Fprintf(&req, "%s %s RTSP/1.0\r\nCSeq: %d\r\n", method, uri, c.cseq)
+
+	if len(config.Settings.UserAgent) != 0 {
+		fmt.Fprintf(&req, "User-Agent: %s\r\n", config.Settings.UserAgent)
+	}
+
+	if len(c.session) > 0 {
+		fmt.Fprintf(&req, "Session: %s\r\n", c.session)
+	}
+
+	if len(c.auth) > 0 {
+		fmt.Fprintf(&req, "Authorization: %s\r\n", c.authorization(method, uri))
+	}
+
+	for key, value := range header {
+		fmt.Fprintf(&req, "%s: %s\r\n", key, value)
+	}
+
+	req.WriteString("\r\n")
+
+	_, err = c.conn.Write(req.Bytes())
+
+	return
+}
+
+// readResponse : Reads the next RTSP response, interleaved RTP packets before the response are skipped
+func (c *rtspClient) readResponse() (resp *rtspResponse, err error) {
+
+	for {
+
+		first, err := c.reader.Peek(1)
+		if err != nil {
+			return nil, err
+		}
+
+		if first[0] != '$' {
+			break
+		}
+
+		if _, _, err = c.readFrame(); err != nil {
+			return nil, err
+		}
+
+	}
+
+	var tp = textproto.NewReader(c.reader)
+
+	line, err := tp.ReadLine()
+	if err != nil {
+		return
+	}
+
+	// RTSP/1.0 200 OK
+	var fields = strings.Fields(line)
+	if len(fields) < 2 || !strings.HasPrefix(fields[0], "RTSP/") {
+		err = fmt.Errorf("invalid RTSP response: %s", line)
+		return
+	}
+
+	resp = &rtspResponse{}
+
+	resp.status, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return
+	}
+
+	resp.header, err = tp.ReadMIMEHeader()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return
+	}
+
+	if length, _ := strconv.Atoi(resp.header.Get("Content-Length")); length > 0 {
+
+		if length > rtspMaxBody {
+			return nil, fmt.Errorf("RTSP response too large: %d bytes", length)
+		}
+
+		resp.body = make([]byte, length)
+		_, err = io.ReadFull(io.LimitReader(c.reader, int64(length)), resp.body)
+
+	}
+
+	return
+}
+
+// readFrame : Reads an interleaved packet ($, channel, length, data)
+func (c *rtspClient) readFrame() (channel byte, data []byte, err error) {
+
+	var header = make([]byte, 4)
+	if _, err = io.ReadFull(c.reader, header); err != nil {
+		return
+	}
+
+	if header[0] != '$' {
+		err = fmt.Errorf("invalid RTSP interleaved frame")
+		return
+	}
+
+	channel = header[1]
+	data = make([]byte, binary.BigEndian.Uint16(header[2:]))
+	_, err = io.ReadFull(c.reader, data)
+
+	return
+}
+
+// authorization : Authorization header for the request (Basic or Digest)
+func (c *rtspClient) authorization(method, uri string) string {
+
+	var user = c.user.Username()
+	var password, _ = c.user.Password()
+
+	if strings.HasPrefix(strings.ToLower(c.auth), "basic") {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+	}
+
+	var params = parseAuthParams(c.auth[len("Digest"):])
+	var ha1 = md5Hex(user + ":" + params["realm"] + ":" + password)
+	var ha2 = md5Hex(method + ":" + uri)
+	var response string
+
+	if strings.Contains(params["qop"], "auth") {
+
+		var cnonce = md5Hex(strconv.FormatInt(time.Now().UnixNano(), 10))[:16]
+		response = md5Hex(ha1 + ":" + params["nonce"] + ":00000001:" + cnonce + ":auth:" + ha2)
+
+		return fmt.Sprintf(`Digest username="%s", realm="%s", nonce="%s", uri="%s", response="%s", qop=auth, nc=00000001, cnonce="%s"`, user, params["realm"], params["nonce"], uri, response, cnonce)
+	}
+
+	response = md5Hex(ha1 + ":" + params["nonce"] + ":" + ha2)
+
+	return fmt.Sprintf(`Digest username="%s", realm="%s", nonce="%s", uri="%s", response="%s"`, user, params["realm"], params["nonce"], uri, response)
+}
+
+// keepAlive : Sends OPTIONS within the session timeout of the server, the responses are skipped by the read loop
+func (c *rtspClient) keepAlive(ctx context.Context) {
+
+	var ticker = time.NewTicker(c.timeout / 2)
+	defer ticker.Stop()
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if err := c.send("OPTIONS", c.url, nil); err != nil {
+				return
+			}
+
+		}
+
+	}
+
+}
+
+// close : Ends the session (TEARDOWN) and closes the connection
+func (c *rtspClient) close() {
+
+	if len(c.session) > 0 {
+		_ = c.conn.SetWriteDeadline(time.Now().Add(time.Second))
+		_ = c.send("TEARDOWN", c.url, nil)
+	}
+
+	_ = c.conn.Close()
+}
+
+// setup : SETUP of the MPEG-TS stream with RTP over TCP, returns the channel of the RTP packets
+func (c *rtspClient) setup(control string) (channel byte, err error) {
+
+	resp, err := c.request("SETUP", control, map[string]string{"Transport": "RTP/AVP/TCP;unicast;interleaved=0-1"})
+	if err != nil {
+		return
+	}
+
+	// Session: 12345678;timeout=60
+	var session = strings.Split(resp.header.Get("Session"), ";")
+	c.session = strings.TrimSpace(session[0])
+
+	for _, param := range session[1:] {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(param), "timeout="); ok {
+			if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+				c.timeout = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	for _, param := range strings.Split(resp.header.Get("Transport"), ";") {
+		if value, ok := strings.CutPrefix(param, "interleaved="); ok {
+			if first, err := strconv.Atoi(strings.Split(value, "-")[0]); err == nil {
+				channel = byte(first)
+			}
+		}
+	}
+
+	return
+}
+
+// bufferRTSP : Receives the RTP/MP2T stream of a RTSP server, the data is split into segments of half the buffer size.
+// SAT>IP servers that do not support DESCRIBE are set up with the URL itself.
+func bufferRTSP(ctx context.Context, stream *structs.ThisStream, streamURL string, segments *segmentWriter) (err error) {
+
+	c, err := dialRTSP(ctx, streamURL)
+	if err != nil {
+		return
+	}
+
+	var done = make(chan struct{})
+	defer close(done)
+
+	// The connection is closed when the watchdog cancels the context
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = c.conn.Close()
+		case <-done:
+		}
+	}()
+
+	defer c.close()
+
+	var control = c.url
+
+	resp, err := c.request("DESCRIBE", c.url, map[string]string{"Accept": "application/sdp"})
+	if err == nil {
+
+		control, err = parseSDP(resp.body, rtspContentBase(resp, c.url))
+		if err != nil {
+			return
+		}
+
+	} else {
+		cli.ShowDebug(fmt.Sprintf("RTSP:%s, SETUP without DESCRIBE", err), 1)
+	}
+
+	channel, err := c.setup(control)
+	if err != nil {
+		return
+	}
+
+	_, err = c.request("PLAY", c.url, map[string]string{"Range": "npt=0.000-"})
+	if err != nil {
+		return
+	}
+
+	go c.keepAlive(ctx)
+
+	cli.ShowInfo("Streaming Status:Receive data from THREADFIN (RTSP)")
+
+	var bufferSize = config.Settings.BufferSize * 1024
+	var buf bytes.Buffer
+
+	for {
+
+		first, err := c.reader.Peek(1)
+		if err != nil {
+			return err
+		}
+
+		// Response to the keep-alive
+		if first[0] != '$' {
+
+			if _, err = c.readResponse(); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		ch, data, err := c.readFrame()
+		if err != nil {
+			return err
+		}
+
+		if ch != channel {
+			continue // RTCP
+		}
+
+		buf.Write(rtpPayload(data))
+
+		if buf.Len() >= bufferSize/2 {
+
+			err = segments.write(buf.Bytes())
+			if err != nil {
+				return err
+			}
+
+			buf.Reset()
+
+			if !client.Connection(*stream) {
+				return errClientDisconnected
+			}
+
+		}
+
+	}
+
+}
+
+// rtspContentBase : Base URL for the control attributes of the SDP
+func rtspContentBase(resp *rtspResponse, requestURL string) string {
+
+	for _, key := range []string{"Content-Base", "Content-Location"} {
+		if base := resp.header.Get(key); len(base) > 0 {
+			return base
+		}
+	}
+
+	return requestURL
+}
+
+// parseSDP : Control URL of the MPEG-TS (MP2T) media of the session description.
+// Other media (e.g. H.264 of IP cameras) can not be passed as MPEG-TS by Threadfin, the FFmpeg buffer can be used instead.
+func parseSDP(sdp []byte, base string) (control string, err error) {
+
+	var media, mediaControl, sessionControl string
+	var mp2t = false
+
+	var resolve = func(c string) string {
+
+		switch {
+		case c == "*" || len(c) == 0:
+			return base
+		case strings.Contains(c, "://"):
+			return c
+		case strings.HasSuffix(base, "/"):
+			return base + c
+		}
+
+		return base + "/" + c
+	}
+
+	var found = func() bool {
+		if mp2t {
+			if len(mediaControl) > 0 {
+				control = resolve(mediaControl)
+			} else {
+				control = resolve(sessionControl)
+			}
+			return true
+		}
+		return false
+	}
+
+	for _, line := range strings.Split(string(sdp), "\n") {
+
+		line = strings.TrimSpace(line)
+
+		switch {
+
+		case strings.HasPrefix(line, "m="):
+
+			if found() {
+				return
+			}
+
+			// m=video 0 RTP/AVP 33
+			var fields = strings.Fields(line[2:])
+			media = line
+			mediaControl = ""
+			mp2t = len(fields) >= 4 && fields[3] == "33"
+
+		case strings.HasPrefix(line, "a=rtpmap:"):
+			if strings.Contains(strings.ToUpper(line), "MP2T/") {
+				mp2t = true
+			}
+
+		case strings.HasPrefix(line, "a=control:"):
+			if len(media) == 0 {
+				sessionControl = strings.TrimPrefix(line, "a=control:")
+			} else {
+				mediaControl = strings.TrimPrefix(line, "a=control:")
+			}
+
+		}
+
+	}
+
+	if found() {
+		return
+	}
+
+	err = errors.New("RTSP stream does not contain MPEG-TS (MP2T), use the FFmpeg buffer for this stream")
+
+	return
+}
+
+// selectAuthenticate : Digest before Basic
+func selectAuthenticate(values []string) (auth string) {
+
+	for _, value := range values {
+
+		switch {
+		case strings.HasPrefix(strings.ToLower(value), "digest"):
+			return value
+		case strings.HasPrefix(strings.ToLower(value), "basic"):
+			auth = value
+		}
+
+	}
+
+	return
+}
+
+// parseAuthParams : realm="x", nonce="y", qop="auth"
+func parseAuthParams(s string) map[string]string {
+
+	var params = make(map[string]string)
+
+	for len(s) > 0 {
+
+		s = strings.TrimLeft(s, " ,")
+
+		key, rest, ok := strings.Cut(s, "=")
+		if !ok {
+			break
+		}
+
+		var value string
+
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			value = rest[1 : end+1]
+			s = rest[min(end+2, len(rest)):]
+		} else {
+			value, s, _ = strings.Cut(rest, ",")
+		}
+
+		params[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+	}
+
+	return params
+}
+
+func md5Hex(s string) string {
+	var sum = md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
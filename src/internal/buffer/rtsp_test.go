@@ -0,0 +1,207 @@
+package buffer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+	"time"
+)
+
+func TestParseSDP(t *testing.T) {
+
+	var sdp = "v=0\r\no=- 0 0 IN IP4 127.0.0.1\r\ns=Stream\r\na=control:*\r\n" +
+		"m=audio 0 RTP/AVP 97\r\na=rtpmap:97 MPEG4-GENERIC/48000/2\r\na=control:trackID=1\r\n" +
+		"m=video 0 RTP/AVP 33\r\na=control:trackID=2\r\n"
+
+	control, err := parseSDP([]byte(sdp), "rtsp://127.0.0.1/live/")
+	if err != nil || control != "rtsp://127.0.0.1/live/trackID=2" {
+		t.Errorf("unexpected control: %s %v", control, err)
+	}
+
+	sdp = "v=0\r\nm=video 0 RTP/AVP 96\r\na=rtpmap:96 MP2T/90000\r\n"
+
+	control, err = parseSDP([]byte(sdp), "rtsp://127.0.0.1/live")
+	if err != nil || control != "rtsp://127.0.0.1/live" {
+		t.Errorf("unexpected control: %s %v", control, err)
+	}
+
+	sdp = "v=0\r\nm=video 0 RTP/AVP 96\r\na=rtpmap:96 H264/90000\r\na=control:rtsp://127.0.0.1/cam/track1\r\n"
+
+	if _, err = parseSDP([]byte(sdp), "rtsp://127.0.0.1/cam"); err == nil {
+		t.Error("expected error for H.264 stream")
+	}
+
+}
+
+func TestParseAuthParams(t *testing.T) {
+
+	var params = parseAuthParams(` realm="IP Camera, 1", nonce="abc", qop="auth", stale=FALSE`)
+
+	if params["realm"] != "IP Camera, 1" || params["nonce"] != "abc" || params["qop"] != "auth" || params["stale"] != "FALSE" {
+		t.Errorf("unexpected parameters: %v", params)
+	}
+
+}
+
+func TestRTSPResponse(t *testing.T) {
+
+	var sdp = "v=0\r\ns=Test\r\n"
+	var c = &rtspClient{reader: bufio.NewReader(strings.NewReader(fmt.Sprintf("RTSP/1.0 200 OK\r\nCSeq: 1\r\nContent-Length: %d\r\n\r\n%s", len(sdp), sdp)))}
+
+	if resp, err := c.readResponse(); err != nil || resp.status != 200 || string(resp.body) != sdp {
+		t.Errorf("unexpected response: %+v %v", resp, err)
+	}
+
+	// The body is not allocated
+	c = &rtspClient{reader: bufio.NewReader(strings.NewReader("RTSP/1.0 200 OK\r\nCSeq: 2\r\nContent-Length: 4294967296\r\n\r\n"))}
+
+	if _, err := c.readResponse(); err == nil {
+		t.Error("response with 4 GB accepted")
+	}
+
+}
+
+// rtspTestServer : RTSP server with Digest authentication that sends RTP/MP2T packets interleaved over TCP.
+// satip: DESCRIBE is not supported.
+func rtspTestServer(t *testing.T, data []byte, satip bool) string {
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var reader = textproto.NewReader(bufio.NewReader(conn))
+
+		for {
+
+			line, err := reader.ReadLine()
+			if err != nil {
+				return
+			}
+
+			header, _ := reader.ReadMIMEHeader()
+			var method = strings.Fields(line)[0]
+			var cseq = header.Get("Cseq")
+
+			if !strings.HasPrefix(header.Get("Authorization"), `Digest username="user", realm="cam", nonce="123", uri="`) {
+				fmt.Fprintf(conn, "RTSP/1.0 401 Unauthorized\r\nCSeq: %s\r\nWWW-Authenticate: Basic realm=\"cam\"\r\nWWW-Authenticate: Digest realm=\"cam\", nonce=\"123\"\r\n\r\n", cseq)
+				continue
+			}
+
+			switch method {
+
+			case "DESCRIBE":
+				if satip {
+					fmt.Fprintf(conn, "RTSP/1.0 404 Not Found\r\nCSeq: %s\r\n\r\n", cseq)
+					continue
+				}
+				var sdp = "v=0\r\ns=Test\r\nm=video 0 RTP/AVP 33\r\na=control:track1\r\n"
+				fmt.Fprintf(conn, "RTSP/1.0 200 OK\r\nCSeq: %s\r\nContent-Base: %s/\r\nContent-Type: application/sdp\r\nContent-Length: %d\r\n\r\n%s", cseq, strings.Fields(line)[1], len(sdp), sdp)
+
+			case "SETUP":
+				if !satip && !strings.HasSuffix(strings.Fields(line)[1], "/track1") {
+					fmt.Fprintf(conn, "RTSP/1.0 454 Session Not Found\r\nCSeq: %s\r\n\r\n", cseq)
+					continue
+				}
+				fmt.Fprintf(conn, "RTSP/1.0 200 OK\r\nCSeq: %s\r\nSession: 4711;timeout=60\r\nTransport: RTP/AVP/TCP;unicast;interleaved=2-3\r\n\r\n", cseq)
+
+			case "PLAY":
+				if header.Get("Session") != "4711" {
+					fmt.Fprintf(conn, "RTSP/1.0 454 Session Not Found\r\nCSeq: %s\r\n\r\n", cseq)
+					continue
+				}
+				fmt.Fprintf(conn, "RTSP/1.0 200 OK\r\nCSeq: %s\r\n\r\n", cseq)
+
+				for i := 0; i < len(data); i += 7 * tsPacketSize {
+
+					var packet = append([]byte{0x80, 33, 0, byte(i), 0, 0, 0, 0, 0, 0, 0, 1}, data[i:min(i+7*tsPacketSize, len(data))]...)
+
+					// RTCP on the second channel
+					_, _ = conn.Write([]byte{'$', 3, 0, 4, 0x80, 200, 0, 0})
+
+					var frame = []byte{'$', 2, 0, 0}
+					binary.BigEndian.PutUint16(frame[2:], uint16(len(packet)))
+					_, _ = conn.Write(append(frame, packet...))
+
+				}
+
+			case "TEARDOWN":
+				return
+
+			}
+
+		}
+
+	}()
+
+	return "rtsp://user:secret@" + listener.Addr().String() + "/live/ch1"
+}
+
+func TestBufferRTSP(t *testing.T) {
+
+	for _, satip := range []bool{false, true} {
+
+		t.Run(fmt.Sprintf("satip=%t", satip), func(t *testing.T) {
+
+			var b = newTestBroadcaster(t)
+			var r = b.NewReader()
+			var segments = &segmentWriter{broadcaster: b, playlistID: "M-test", streamID: 0}
+			var stream = structs.ThisStream{PlaylistID: "M-test", MD5: "rtsp"}
+
+			config.BufferClients.Store(stream.PlaylistID+stream.MD5, structs.ClientConnection{Connection: 1})
+			defer config.BufferClients.Delete(stream.PlaylistID + stream.MD5)
+
+			// 35 packets fit into the ring of the test broadcaster (10 KB), one segment per RTP packet (7 TS packets)
+			var data = packets(35, 0)
+			var streamURL = rtspTestServer(t, data, satip)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			var done = make(chan error, 1)
+			go func() {
+				done <- bufferRTSP(ctx, &stream, streamURL, segments)
+			}()
+
+			var received []byte
+			var p = make([]byte, len(data))
+
+			for len(received) < len(data) {
+
+				n, err := r.ReadTimeout(p, 2*time.Second)
+				if err != nil || n == 0 {
+					t.Fatalf("no data from the RTSP server: %v", err)
+				}
+
+				received = append(received, p[:n]...)
+			}
+
+			if !bytes.Equal(received, data) {
+				t.Error("unexpected data from the RTSP server")
+			}
+
+			cancel()
+			<-done
+
+		})
+
+	}
+
+}
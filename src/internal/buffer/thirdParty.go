@@ -2,10 +2,9 @@ package buffer
 
 import (
 	"bufio"
-	"bytes"
+	"context"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -17,446 +16,295 @@ import (
 	"time"
 )
 
-// Buffer with FFMPEG and VLC
-func ThirdParty(streamID int, playlistID string, useBackup bool, backupNumber int) {
-
-	if p, ok := config.BufferInformation.Load(playlistID); ok {
-
-		var playlist = p.(structs.Playlist)
-		var debug, path, options, bufferType string
-		var tmpSegment = 1
-		var bufferSize = config.Settings.BufferSize * 1024
-		var stream = playlist.Streams[streamID]
-		var buf bytes.Buffer
-		var fileSize = 0
-		var streamStatus = make(chan bool)
-
-		var tmpFolder = playlist.Streams[streamID].Folder
-		var url = playlist.Streams[streamID].URL
-		if useBackup {
-			if backupNumber >= 1 && backupNumber <= 3 {
-				switch backupNumber {
-				case 1:
-					if stream.BackupChannel1 != nil {
-						url = stream.BackupChannel1.URL
-						cli.ShowHighlight("START OF BACKUP 1 STREAM")
-						cli.ShowInfo("Backup Channel 1 URL: " + url)
-					}
-				case 2:
-					if stream.BackupChannel2 != nil {
-						url = stream.BackupChannel2.URL
-						cli.ShowHighlight("START OF BACKUP 2 STREAM")
-						cli.ShowInfo("Backup Channel 2 URL: " + url)
-					}
-				case 3:
-					if stream.BackupChannel3 != nil {
-						url = stream.BackupChannel3.URL
-						cli.ShowHighlight("START OF BACKUP 3 STREAM")
-						cli.ShowInfo("Backup Channel 3 URL: " + url)
-					}
-				}
-			}
-		}
+// Buffer with FFMPEG and VLC. The process is stopped when ctx is canceled (watchdog).
+func ThirdParty(ctx context.Context, streamID int, playlistID string, streamURL string, broadcaster *Broadcaster) (err error) {
 
-		stream.Status = false
+	p, ok := config.BufferInformation.Load(playlistID)
+	if !ok {
+		return errClientDisconnected
+	}
 
-		bufferType = strings.ToUpper(playlist.Buffer)
+	var playlist = p.(structs.Playlist)
+	var path, options, bufferType string
+	var stream = playlist.Streams[streamID]
 
-		switch playlist.Buffer {
+	var url = streamURL
 
-		case "ffmpeg":
+	bufferType = strings.ToUpper(playlist.Buffer)
 
-			if config.Settings.FFmpegForceHttp {
-				url = strings.ReplaceAll(url, "https://", "http://")
-				cli.ShowInfo("Forcing URL to HTTP for FFMPEG: " + url)
-			}
+	var profile = getBufferProfile(playlist, stream)
+	path = profile.Path
+	options = profile.Options
 
-			path = config.Settings.FFmpegPath
-			options = config.Settings.FFmpegOptions
+	if playlist.Buffer == "ffmpeg" && config.Settings.FFmpegForceHttp {
+		url = strings.ReplaceAll(url, "https://", "http://")
+		cli.ShowInfo("Forcing URL to HTTP for FFMPEG: " + url)
+	}
 
-		case "vlc":
-			path = config.Settings.VLCPath
-			options = config.Settings.VLCOptions
+	err = storage.CheckFile(path)
+	if err != nil {
+		return
+	}
 
-		default:
-			return
-		}
+	cli.ShowInfo(fmt.Sprintf("%s path:%s", bufferType, path))
+	if len(profile.Name) > 0 {
+		cli.ShowInfo(fmt.Sprintf("%s profile:%s", bufferType, profile.Name))
+	}
+	cli.ShowInfo("Streaming URL:" + url)
 
-		var addErrorToStream = func(err error) {
-			if !useBackup || (useBackup && backupNumber >= 0 && backupNumber <= 3) {
-				backupNumber = backupNumber + 1
-				if stream.BackupChannel1 != nil || stream.BackupChannel2 != nil || stream.BackupChannel3 != nil {
-					ThirdParty(streamID, playlistID, true, backupNumber)
-				}
-				return
-			}
+	// Set User-Agent
+	var args []string
 
-			var stream = playlist.Streams[streamID]
+	for i, a := range strings.Split(options, " ") {
 
-			if c, ok := config.BufferClients.Load(playlistID + stream.MD5); ok {
+		switch bufferType {
+		case "FFMPEG":
+			a = strings.ReplaceAll(a, "[URL]", url)
+			if i == 0 {
+				if len(config.Settings.UserAgent) != 0 {
+					args = []string{"-user_agent", config.Settings.UserAgent}
+				}
 
-				var clients = c.(structs.ClientConnection)
-				clients.Error = err
-				config.BufferClients.Store(playlistID+stream.MD5, clients)
+				if playlist.HttpProxyIP != "" && playlist.HttpProxyPort != "" {
+					args = append(args, "-http_proxy", fmt.Sprintf("http://%s:%s", playlist.HttpProxyIP, playlist.HttpProxyPort))
+				}
 
+				var headers string
+				if len(playlist.HttpUserReferer) != 0 {
+					headers += fmt.Sprintf("Referer: %s\r\n", playlist.HttpUserReferer)
+				}
+				if len(playlist.HttpUserOrigin) != 0 {
+					headers += fmt.Sprintf("Origin: %s\r\n", playlist.HttpUserOrigin)
+				}
+				for _, header := range profile.Headers {
+					headers += fmt.Sprintf("%s\r\n", header)
+				}
+				if headers != "" {
+					args = append(args, "-headers", headers)
+				}
 			}
 
-		}
-
-		if err := config.BufferVFS.RemoveAll(storage.GetPlatformPath(tmpFolder)); err != nil {
-			cli.ShowError(err, 4005)
-		}
-
-		err := storage.CheckVFSFolder(tmpFolder, config.BufferVFS)
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
-
-		err = storage.CheckFile(path)
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
-
-		cli.ShowInfo(fmt.Sprintf("%s path:%s", bufferType, path))
-		cli.ShowInfo("Streaming URL:" + url)
+			args = append(args, a)
 
-		var tmpFile = fmt.Sprintf("%s%d.ts", tmpFolder, tmpSegment)
-
-		f, err := config.BufferVFS.Create(tmpFile)
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
-
-		defer func() {
-			err = f.Close()
-		}()
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
-
-		// Set User-Agent
-		var args []string
-
-		for i, a := range strings.Split(options, " ") {
-
-			switch bufferType {
-			case "FFMPEG":
+		case "VLC":
+			if a == "[URL]" {
 				a = strings.ReplaceAll(a, "[URL]", url)
-				if i == 0 {
-					if len(config.Settings.UserAgent) != 0 {
-						args = []string{"-user_agent", config.Settings.UserAgent}
-					}
-
-					if playlist.HttpProxyIP != "" && playlist.HttpProxyPort != "" {
-						args = append(args, "-http_proxy", fmt.Sprintf("http://%s:%s", playlist.HttpProxyIP, playlist.HttpProxyPort))
-					}
+				args = append(args, a)
 
-					var headers string
-					if len(playlist.HttpUserReferer) != 0 {
-						headers += fmt.Sprintf("Referer: %s\r\n", playlist.HttpUserReferer)
-					}
-					if len(playlist.HttpUserOrigin) != 0 {
-						headers += fmt.Sprintf("Origin: %s\r\n", playlist.HttpUserOrigin)
-					}
-					if headers != "" {
-						args = append(args, "-headers", headers)
-					}
+				if len(config.Settings.UserAgent) != 0 {
+					args = append(args, fmt.Sprintf(":http-user-agent=%s", config.Settings.UserAgent))
 				}
 
-				args = append(args, a)
+				if len(playlist.HttpUserReferer) != 0 {
+					args = append(args, fmt.Sprintf(":http-referrer=%s", playlist.HttpUserReferer))
+				}
 
-			case "VLC":
-				if a == "[URL]" {
-					a = strings.ReplaceAll(a, "[URL]", url)
-					args = append(args, a)
+				if playlist.HttpProxyIP != "" && playlist.HttpProxyPort != "" {
+					args = append(args, fmt.Sprintf(":http-proxy=%s:%s", playlist.HttpProxyIP, playlist.HttpProxyPort))
+				}
 
-					if len(config.Settings.UserAgent) != 0 {
-						args = append(args, fmt.Sprintf(":http-user-agent=%s", config.Settings.UserAgent))
-					}
+				// VLC only supports the User-Agent and the Referer as HTTP header
+				for _, header := range profile.Headers {
 
-					if len(playlist.HttpUserReferer) != 0 {
-						args = append(args, fmt.Sprintf(":http-referrer=%s", playlist.HttpUserReferer))
-					}
+					name, value, _ := strings.Cut(header, ":")
 
-					if playlist.HttpProxyIP != "" && playlist.HttpProxyPort != "" {
-						args = append(args, fmt.Sprintf(":http-proxy=%s:%s", playlist.HttpProxyIP, playlist.HttpProxyPort))
+					switch strings.ToLower(strings.TrimSpace(name)) {
+					case "user-agent":
+						args = append(args, fmt.Sprintf(":http-user-agent=%s", strings.TrimSpace(value)))
+					case "referer":
+						args = append(args, fmt.Sprintf(":http-referrer=%s", strings.TrimSpace(value)))
+					default:
+						cli.ShowDebug(fmt.Sprintf("VLC:HTTP header is not supported (%s)", header), 1)
 					}
 
-				} else {
-					args = append(args, a)
 				}
 
+			} else {
+				args = append(args, a)
 			}
 
 		}
 
-		var cmd = exec.Command(path, args...)
-		// Set this explicitly to avoid issues with VLC
-		cmd.Env = append(os.Environ(), "DISPLAY=:0")
+	}
 
-		debug = fmt.Sprintf("BUFFER DEBUG: %s:%s %s", bufferType, path, args)
-		cli.ShowDebug(debug, 1)
+	return runProcess(ctx, bufferType, path, args, streamID, playlistID, broadcaster)
+}
 
-		// Byte data from the process
-		stdOut, err := cmd.StdoutPipe()
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
+// runProcess : Runs the buffer process (FFmpeg, VLC, command) and writes its output (MPEG-TS on stdout) into the broadcaster.
+// The process is killed as soon as the watchdog cancels the context.
+func runProcess(ctx context.Context, bufferType string, path string, args []string, streamID int, playlistID string, broadcaster *Broadcaster) (err error) {
 
-		// Log data from the process
-		logOut, err := cmd.StderrPipe()
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
+	p, ok := config.BufferInformation.Load(playlistID)
+	if !ok {
+		return errClientDisconnected
+	}
 
-		if len(buf.Bytes()) == 0 && !stream.Status {
-			cli.ShowInfo(bufferType + ":Processing data")
-		}
+	var debug string
+	var buffered = false
+	var bufferSize = config.Settings.BufferSize * 1024
+	var stream = p.(structs.Playlist).Streams[streamID]
+	var fileSize = 0
+	var written = 0
+	var streamStatus = make(chan bool)
 
-		err = cmd.Start()
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
+	var cmd = exec.CommandContext(ctx, path, args...)
+	// Set this explicitly to avoid issues with VLC
+	cmd.Env = append(os.Environ(), "DISPLAY=:0")
 
-		defer func() {
-			err = cmd.Wait()
-		}()
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
+	debug = fmt.Sprintf("BUFFER DEBUG: %s:%s %s", bufferType, path, args)
+	cli.ShowDebug(debug, 1)
 
-		go func() {
+	// Byte data from the process
+	stdOut, err := cmd.StdoutPipe()
+	if err != nil {
+		return
+	}
 
-			// Display log data from the process in debug mode 1.
-			scanner := bufio.NewScanner(logOut)
-			scanner.Split(bufio.ScanLines)
+	// Log data from the process
+	logOut, err := cmd.StderrPipe()
+	if err != nil {
+		return
+	}
 
-			for scanner.Scan() {
+	if !stream.Status {
+		cli.ShowInfo(bufferType + ":Processing data")
+	}
 
-				debug = fmt.Sprintf("%s log:%s", bufferType, strings.TrimSpace(scanner.Text()))
+	err = cmd.Start()
+	if err != nil {
+		return
+	}
 
-				select {
-				case <-streamStatus:
-					cli.ShowDebug(debug, 1)
-				default:
-					cli.ShowInfo(debug)
-				}
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
 
-				time.Sleep(time.Duration(10) * time.Millisecond)
+	go func() {
 
-			}
+		// Display log data from the process in debug mode 1.
+		scanner := bufio.NewScanner(logOut)
+		scanner.Split(bufio.ScanLines)
 
-		}()
+		for scanner.Scan() {
 
-		f, err = config.BufferVFS.OpenFile(tmpFile, os.O_APPEND|os.O_WRONLY, 0600)
-		if err != nil {
-			panic(err)
-		}
-		defer func() {
-			err = f.Close()
-		}()
-		if err != nil {
-			cli.ShowError(err, 0)
-			client.KillConnection(streamID, playlistID, false)
-			addErrorToStream(err)
-			return
-		}
+			debug = fmt.Sprintf("%s log:%s", bufferType, strings.TrimSpace(scanner.Text()))
 
-		buffer := make([]byte, 1024*4)
+			select {
+			case <-streamStatus:
+				cli.ShowDebug(debug, 1)
+			default:
+				cli.ShowInfo(debug)
+			}
 
-		reader := bufio.NewReader(stdOut)
+			time.Sleep(time.Duration(10) * time.Millisecond)
 
-		t := make(chan int)
+		}
 
-		go func() {
+	}()
 
-			var timeout = 0
-			for {
-				time.Sleep(time.Duration(1000) * time.Millisecond)
-				timeout++
+	buffer := make([]byte, 1024*4)
 
-				select {
-				case <-t:
-					return
-				default:
-					// Check if the channel is closed before sending
-					select {
-					case t <- timeout:
-					default:
-					}
-				}
+	reader := bufio.NewReader(stdOut)
 
-			}
+	for {
 
-		}()
+		if written == 0 && !stream.Status {
+			cli.ShowInfo("Streaming Status:Receive data from " + bufferType)
+		}
 
-		for {
+		if !client.Connection(stream) {
+			return errClientDisconnected
+		}
 
-			select {
-			case timeout := <-t:
-				if timeout >= 20 && tmpSegment == 1 {
-					err = cmd.Process.Kill()
-					if err != nil {
-						cli.ShowError(err, 0)
-					}
-					err = errors.New("Timeout")
-					cli.ShowError(err, 4006)
-					client.KillConnection(streamID, playlistID, false)
-					addErrorToStream(err)
-					err = cmd.Wait()
-					if err != nil {
-						cli.ShowError(err, 0)
-					}
-					err = f.Close()
-					if err != nil {
-						cli.ShowError(err, 0)
-					}
-					return
-				}
+		n, err := reader.Read(buffer)
+		if err != nil {
+			// io.EOF: the process has ended or was killed
+			break
+		}
 
-			default:
+		fileSize = fileSize + len(buffer[:n])
+		written = written + len(buffer[:n])
 
-			}
+		if _, err := broadcaster.Write(buffer[:n]); err != nil {
 
-			if fileSize == 0 && !stream.Status {
-				cli.ShowInfo("Streaming Status:Receive data from " + bufferType)
+			// All clients have left, the broadcaster has been closed
+			if errors.Is(err, errBroadcasterClosed) {
+				return errClientDisconnected
 			}
 
-			if !client.Connection(stream) {
-				err = cmd.Process.Kill()
-				if err != nil {
-					cli.ShowError(err, 0)
-				}
-
-				err = f.Close()
-				if err != nil {
-					cli.ShowError(err, 0)
-				}
+			return err
+		}
 
-				err = cmd.Wait()
-				if err != nil {
-					cli.ShowError(err, 0)
-				}
-				return
-			}
+		if fileSize >= bufferSize/2 {
 
-			n, err := reader.Read(buffer)
-			if err == io.EOF {
-				break
+			if !buffered {
+				buffered = true
+				close(streamStatus)
+				cli.ShowInfo(fmt.Sprintf("Streaming Status:Buffering data from %s", bufferType))
 			}
 
-			fileSize = fileSize + len(buffer[:n])
-
-			if _, err := f.Write(buffer[:n]); err != nil {
-				cli.ShowError(err, 0)
-				err = cmd.Process.Kill()
-				if err != nil {
-					cli.ShowError(err, 0)
-				}
-				client.KillConnection(streamID, playlistID, false)
-				addErrorToStream(err)
-				err = cmd.Wait()
-				if err != nil {
-					cli.ShowError(err, 0)
-				}
+			fileSize = 0
 
-				return
+			if !stream.Status {
+				stream.Status = true
+				setStreamStatus(playlistID, streamID, true)
 			}
 
-			if fileSize >= bufferSize/2 {
+		}
 
-				if tmpSegment == 1 && !stream.Status {
-					close(t)
-					close(streamStatus)
-					cli.ShowInfo(fmt.Sprintf("Streaming Status:Buffering data from %s", bufferType))
-				}
+	}
 
-				err = f.Close()
-				if err != nil {
-					cli.ShowError(err, 0)
-					return
-				}
+	err = errors.New(bufferType + " error")
+	cli.ShowError(err, 1204)
 
-				tmpSegment++
+	return
+}
 
-				if !stream.Status {
-					config.Lock.Lock()
-					stream.Status = true
-					playlist.Streams[streamID] = stream
-					config.BufferInformation.Store(playlistID, playlist)
-					config.Lock.Unlock()
-				}
+// getBufferProfile : Effective FFmpeg / VLC / command profile of the stream. Channel (XEPG) before provider, without a profile the FFmpeg / VLC / command settings are used.
+func getBufferProfile(playlist structs.Playlist, stream structs.ThisStream) (profile structs.BufferProfile) {
 
-				tmpFile = fmt.Sprintf("%s%d.ts", tmpFolder, tmpSegment)
+	switch playlist.Buffer {
 
-				fileSize = 0
+	case "ffmpeg":
+		profile.Path = config.Settings.FFmpegPath
+		profile.Options = config.Settings.FFmpegOptions
 
-				var errCreate, errOpen error
-				_, errCreate = config.BufferVFS.Create(tmpFile)
-				f, errOpen = config.BufferVFS.OpenFile(tmpFile, os.O_APPEND|os.O_WRONLY, 0600)
-				if errCreate != nil || errOpen != nil {
-					cli.ShowError(err, 0)
-					err = cmd.Process.Kill()
-					if err != nil {
-						cli.ShowError(err, 0)
-					}
+	case "vlc":
+		profile.Path = config.Settings.VLCPath
+		profile.Options = config.Settings.VLCOptions
 
-					client.KillConnection(streamID, playlistID, false)
-					addErrorToStream(err)
-					err = cmd.Wait()
-					if err != nil {
-						cli.ShowError(err, 0)
-					}
-					return
-				}
+	case "command":
+		profile.Path = config.Settings.CommandPath
+		profile.Options = config.Settings.CommandOptions
 
-			}
+	}
 
-		}
+	for _, name := range []string{stream.BufferProfile, playlist.BufferProfile} {
 
-		err = cmd.Process.Kill()
-		if err != nil {
-			cli.ShowError(err, 0)
+		if len(name) == 0 || name == "-" {
+			continue
 		}
 
-		err = cmd.Wait()
-		if err != nil {
-			cli.ShowError(err, 0)
+		p, ok := config.Settings.BufferProfiles[name]
+		if !ok {
+			cli.ShowWarning(4010)
+			continue
 		}
 
-		err = errors.New(bufferType + " error")
-		addErrorToStream(err)
-		cli.ShowError(err, 1204)
+		profile.Name = name
+		profile.Headers = p.Headers
 
-		time.Sleep(time.Duration(500) * time.Millisecond)
-		client.Connection(stream)
+		if len(p.Path) > 0 {
+			profile.Path = p.Path
+		}
 
-		return
+		if len(p.Options) > 0 {
+			profile.Options = p.Options
+		}
 
+		break
 	}
 
+	return
 }
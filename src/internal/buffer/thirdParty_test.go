@@ -0,0 +1,48 @@
+package buffer
+
+import (
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+)
+
+func TestGetBufferProfile(t *testing.T) {
+
+	config.Settings.FFmpegPath = "/usr/bin/ffmpeg"
+	config.Settings.FFmpegOptions = "-i [URL] -c copy -f mpegts pipe:1"
+	config.Settings.BufferProfiles = map[string]structs.BufferProfile{
+		"audio": {Options: "-i [URL] -c:v copy -c:a aac -f mpegts pipe:1", Headers: []string{"X-Test: 1"}},
+		"local": {Path: "/opt/ffmpeg/bin/ffmpeg"},
+	}
+
+	var playlist = structs.Playlist{Buffer: "ffmpeg"}
+	var stream structs.ThisStream
+
+	// Settings
+	var profile = getBufferProfile(playlist, stream)
+	if profile.Path != config.Settings.FFmpegPath || profile.Options != config.Settings.FFmpegOptions || len(profile.Name) != 0 {
+		t.Errorf("unexpected default profile: %+v", profile)
+	}
+
+	// Provider
+	playlist.BufferProfile = "local"
+	profile = getBufferProfile(playlist, stream)
+	if profile.Name != "local" || profile.Path != "/opt/ffmpeg/bin/ffmpeg" || profile.Options != config.Settings.FFmpegOptions {
+		t.Errorf("unexpected provider profile: %+v", profile)
+	}
+
+	// Channel before provider
+	stream.BufferProfile = "audio"
+	profile = getBufferProfile(playlist, stream)
+	if profile.Name != "audio" || profile.Path != config.Settings.FFmpegPath || len(profile.Headers) != 1 {
+		t.Errorf("unexpected channel profile: %+v", profile)
+	}
+
+	// Unknown channel profile, the provider profile is used
+	stream.BufferProfile = "removed"
+	profile = getBufferProfile(playlist, stream)
+	if profile.Name != "local" {
+		t.Errorf("unexpected profile for an unknown channel profile: %+v", profile)
+	}
+
+}
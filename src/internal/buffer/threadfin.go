@@ -0,0 +1,428 @@
+package buffer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"threadfin/internal/cli"
+	"threadfin/internal/client"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+	"time"
+)
+
+// Buffer with Threadfin (MPEG-TS, HLS / M3U8, UDP / RTP multicast and RTSP without third party tools). The connection is closed when ctx is canceled (watchdog).
+func Threadfin(ctx context.Context, streamID int, playlistID string, streamURL string, broadcaster *Broadcaster) (err error) {
+
+	p, ok := config.BufferInformation.Load(playlistID)
+	if !ok {
+		return errClientDisconnected
+	}
+
+	var playlist = p.(structs.Playlist)
+	var stream = playlist.Streams[streamID]
+
+	cli.ShowInfo("Streaming URL:" + streamURL)
+
+	var segments = &segmentWriter{broadcaster: broadcaster, playlistID: playlistID, streamID: streamID}
+
+	if isMulticastURL(streamURL) || isRTSPURL(streamURL) {
+
+		if isRTSPURL(streamURL) {
+			err = bufferRTSP(ctx, &stream, streamURL, segments)
+		} else {
+			err = bufferMulticast(ctx, &stream, streamURL, segments)
+		}
+
+		if err != nil && !errors.Is(err, errClientDisconnected) && ctx.Err() == nil {
+			cli.ShowError(err, 4004)
+		}
+
+		return
+	}
+
+	var httpClient = newStreamClient(playlist)
+
+	resp, err := getStream(ctx, httpClient, playlist, streamURL)
+	if err != nil {
+		cli.ShowError(err, 4004)
+		return
+	}
+
+	var contentType = strings.ToLower(resp.Header.Get("Content-Type"))
+
+	switch {
+
+	case strings.Contains(contentType, "mpegurl") || strings.Contains(strings.ToLower(resp.Request.URL.Path), ".m3u8"):
+		cli.ShowInfo("Streaming Status:Receive data from THREADFIN (HLS)")
+		err = bufferHLS(ctx, httpClient, playlist, &stream, resp, segments)
+
+	case strings.Contains(contentType, "text/html"):
+		err = closeBody(resp)
+		if err != nil {
+			cli.ShowError(err, 0)
+		}
+		err = fmt.Errorf("%s: %s", contentType, streamURL)
+		cli.ShowError(err, 4003)
+
+	default:
+		cli.ShowInfo("Streaming Status:Receive data from THREADFIN (MPEG-TS)")
+		err = bufferTS(&stream, resp, segments)
+
+	}
+
+	return
+}
+
+// errClientDisconnected : Buffer was stopped because no client is using the stream anymore
+var errClientDisconnected = errors.New("no client is using this stream anymore")
+
+// httpStatusError : The streaming server has answered with an HTTP status other than 200
+type httpStatusError struct {
+	code int
+	url  string
+}
+
+func (e httpStatusError) Error() string {
+	return fmt.Sprintf("%d: %s %s", e.code, e.url, http.StatusText(e.code))
+}
+
+// segmentWriter : Writes the buffered segments into the broadcaster of the stream
+type segmentWriter struct {
+	broadcaster *Broadcaster
+	playlistID  string
+	streamID    int
+	segment     int
+}
+
+func (s *segmentWriter) write(data []byte) (err error) {
+
+	s.segment++
+
+	_, err = s.broadcaster.Write(data)
+	if err != nil {
+		if errors.Is(err, errBroadcasterClosed) {
+			err = errClientDisconnected
+		}
+		return
+	}
+
+	var debug = fmt.Sprintf("Buffer Status:Done (Segment %d, %d bytes)", s.segment, len(data))
+	cli.ShowDebug(debug, 2)
+
+	if s.segment == 1 {
+		setStreamStatus(s.playlistID, s.streamID, true)
+	}
+
+	return
+}
+
+// setStreamStatus : Marks the stream as ready (the first segment is available for the clients)
+func setStreamStatus(playlistID string, streamID int, status bool) {
+
+	config.Lock.Lock()
+	defer config.Lock.Unlock()
+
+	if p, ok := config.BufferInformation.Load(playlistID); ok {
+
+		var playlist = p.(structs.Playlist)
+
+		if stream, ok := playlist.Streams[streamID]; ok {
+			stream.Status = status
+			playlist.Streams[streamID] = stream
+			config.BufferInformation.Store(playlistID, playlist)
+		}
+
+	}
+
+}
+
+// newStreamClient : HTTP client for the streaming server, uses the HTTP proxy of the playlist
+func newStreamClient(playlist structs.Playlist) *http.Client {
+
+	var transport = &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		ResponseHeaderTimeout: 20 * time.Second,
+	}
+
+	if playlist.HttpProxyIP != "" && playlist.HttpProxyPort != "" {
+		if proxyURL, err := url.Parse(fmt.Sprintf("http://%s:%s", playlist.HttpProxyIP, playlist.HttpProxyPort)); err == nil {
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+// getStream : Sends a GET request with the User-Agent and the HTTP headers of the playlist
+func getStream(ctx context.Context, httpClient *http.Client, playlist structs.Playlist, streamURL string) (resp *http.Response, err error) {
+
+	req, err := http.NewRequestWithContext(ctx, "GET", streamURL, nil)
+	if err != nil {
+		return
+	}
+
+	if len(config.Settings.UserAgent) != 0 {
+		req.Header.Set("User-Agent", config.Settings.UserAgent)
+	}
+
+	if len(playlist.HttpUserReferer) != 0 {
+		req.Header.Set("Referer", playlist.HttpUserReferer)
+	}
+
+	if len(playlist.HttpUserOrigin) != 0 {
+		req.Header.Set("Origin", playlist.HttpUserOrigin)
+	}
+
+	resp, err = httpClient.Do(req)
+	if err != nil {
+		return
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		err = httpStatusError{code: resp.StatusCode, url: streamURL}
+		return
+	}
+
+	return
+}
+
+// GetStream : GET request of a stream with the HTTP client of the Threadfin buffer (HTTP proxy, User-Agent and HTTP headers of the playlist)
+func GetStream(ctx context.Context, playlist structs.Playlist, streamURL string) (*http.Response, error) {
+	return getStream(ctx, newStreamClient(playlist), playlist, streamURL)
+}
+
+func closeBody(resp *http.Response) error {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	return resp.Body.Close()
+}
+
+// bufferTS : Continuous MPEG-TS stream, the data is split into segments of half the buffer size
+func bufferTS(stream *structs.ThisStream, resp *http.Response, segments *segmentWriter) (err error) {
+
+	var bufferSize = config.Settings.BufferSize * 1024
+	var buf bytes.Buffer
+	var chunk = make([]byte, 1024*4)
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	for {
+
+		n, readErr := resp.Body.Read(chunk)
+		if n > 0 {
+			buf.Write(chunk[:n])
+		}
+
+		if buf.Len() >= bufferSize/2 || (readErr != nil && buf.Len() > 0) {
+
+			err = segments.write(buf.Bytes())
+			if err != nil {
+				return
+			}
+
+			buf.Reset()
+
+			if !client.Connection(*stream) {
+				return errClientDisconnected
+			}
+
+		}
+
+		if readErr == io.EOF {
+			return errors.New("THREADFIN error: end of stream")
+		}
+
+		if readErr != nil {
+			return readErr
+		}
+
+	}
+
+}
+
+// bufferHLS : HLS / M3U8 stream, new segments of the media playlist are downloaded and stored as buffer segments
+func bufferHLS(ctx context.Context, httpClient *http.Client, playlist structs.Playlist, stream *structs.ThisStream, resp *http.Response, segments *segmentWriter) (err error) {
+
+	var body []byte
+	var bandwidthLimit = config.Settings.M3U8AdaptiveBandwidthMBPS * 1000 * 1000
+
+	stream.M3U8URL = resp.Request.URL.String()
+	stream.Sequence = -1
+
+	body, err = io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return
+	}
+
+	for {
+
+		if !client.Connection(*stream) {
+			return errClientDisconnected
+		}
+
+		stream.TimeStart = time.Now()
+
+		var base, _ = url.Parse(stream.M3U8URL)
+		var master bool
+		master, err = parseM3U8(stream, string(body), base)
+		if err != nil {
+			return
+		}
+
+		// Master playlist: select the variant according to the bandwidth limit
+		if master {
+
+			var variant = selectDynamicStream(stream.DynamicStream, bandwidthLimit)
+
+			stream.DynamicBandwidth = len(stream.DynamicStream) > 1
+			stream.M3U8URL = variant.URL
+			stream.NetworkBandwidth = variant.Bandwidth
+
+			cli.ShowInfo(fmt.Sprintf("Streaming Status:HLS variant %s (%d kbit/s)", variant.Resolution, variant.Bandwidth/1000))
+
+			body, err = getM3U8(ctx, httpClient, playlist, stream)
+			if err != nil {
+				return
+			}
+
+			continue
+		}
+
+		// Discontinuity in the media sequence (e.g. server restart): start again at the live edge
+		if stream.Sequence >= 0 && (stream.LastSequence < stream.Sequence || stream.FirstSequence > stream.Sequence+1) {
+			stream.Sequence = -1
+		}
+
+		var endList = strings.Contains(stream.Body, "#EXT-X-ENDLIST")
+
+		var start = 0
+		if stream.Sequence < 0 && !endList && len(stream.Segment) > 3 {
+			start = len(stream.Segment) - 3
+		}
+
+		stream.NewSegCount = 0
+
+		for _, segment := range stream.Segment[start:] {
+
+			if segment.Sequence <= stream.Sequence {
+				continue
+			}
+
+			var calculation structs.BandwidthCalculation
+			calculation.Start = time.Now()
+
+			var data []byte
+			data, err = getSegment(ctx, httpClient, playlist, segment.URL)
+			if err != nil {
+				return
+			}
+
+			calculation.Stop = time.Now()
+			calculation.Size = len(data)
+			calculation.TimeDiff = calculation.Stop.Sub(calculation.Start).Seconds()
+			if calculation.TimeDiff > 0 {
+				calculation.NetworkBandwidth = int(float64(calculation.Size*8) / calculation.TimeDiff)
+			}
+
+			err = segments.write(data)
+			if err != nil {
+				return
+			}
+
+			stream.Sequence = segment.Sequence
+			stream.Duration = segment.Duration
+			stream.NewSegCount++
+
+			if !client.Connection(*stream) {
+				return errClientDisconnected
+			}
+
+			// Switch to a variant with a lower bandwidth if the network is too slow
+			if stream.DynamicBandwidth && calculation.NetworkBandwidth > 0 && calculation.NetworkBandwidth < stream.NetworkBandwidth {
+				var limit = calculation.NetworkBandwidth
+				if bandwidthLimit > 0 && bandwidthLimit < limit {
+					limit = bandwidthLimit
+				}
+
+				if variant := selectDynamicStream(stream.DynamicStream, limit); variant.URL != "" && variant.URL != stream.M3U8URL {
+					cli.ShowInfo(fmt.Sprintf("Streaming Status:HLS variant %s (%d kbit/s)", variant.Resolution, variant.Bandwidth/1000))
+					stream.M3U8URL = variant.URL
+					stream.NetworkBandwidth = variant.Bandwidth
+				}
+			}
+
+		}
+
+		if endList {
+			return errors.New("THREADFIN error: end of HLS playlist")
+		}
+
+		// Reload the playlist: after the target duration if there were new segments, otherwise after half of it
+		stream.TimeEnd = time.Now()
+		stream.TimeDiff = stream.TimeEnd.Sub(stream.TimeStart).Seconds()
+		stream.Wait = stream.TimeSegDuration
+		if stream.NewSegCount == 0 {
+			stream.Wait = stream.TimeSegDuration / 2
+		}
+		stream.Wait = stream.Wait - stream.TimeDiff
+
+		if stream.Wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(stream.Wait * float64(time.Second))):
+			}
+		}
+
+		body, err = getM3U8(ctx, httpClient, playlist, stream)
+		if err != nil {
+			return
+		}
+
+	}
+
+}
+
+// getM3U8 : Downloads the current playlist (stream.M3U8URL), redirects update the URL
+func getM3U8(ctx context.Context, httpClient *http.Client, playlist structs.Playlist, stream *structs.ThisStream) (body []byte, err error) {
+
+	resp, err := getStream(ctx, httpClient, playlist, stream.M3U8URL)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	stream.M3U8URL = resp.Request.URL.String()
+	body, err = io.ReadAll(resp.Body)
+
+	return
+}
+
+// getSegment : Downloads a single HLS media segment
+func getSegment(ctx context.Context, httpClient *http.Client, playlist structs.Playlist, segmentURL string) (data []byte, err error) {
+
+	resp, err := getStream(ctx, httpClient, playlist, segmentURL)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	data, err = io.ReadAll(resp.Body)
+
+	return
+}
@@ -0,0 +1,255 @@
+package buffer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"threadfin/internal/cli"
+	"threadfin/internal/client"
+	"threadfin/internal/config"
+	"threadfin/internal/slate"
+	"threadfin/internal/stalker"
+	"threadfin/internal/structs"
+	"time"
+)
+
+// errStreamStalled : The streaming server has not sent any new data within the stall timeout
+var errStreamStalled = errors.New("no data from the streaming server")
+
+// errStreamEnded : The streaming server has ended the stream
+var errStreamEnded = errors.New("stream has ended")
+
+// errStreamFrozen : The streaming server sends data, but the video has not progressed within the stall timeout
+var errStreamFrozen = errors.New("video of the stream is frozen")
+
+// errStreamCorrupted : Too many continuity counter errors in the stream
+var errStreamCorrupted = errors.New("stream is corrupted")
+
+// Start : Starts the buffer of the stream (Threadfin, FFmpeg, VLC, command). If the streaming server stops sending data or the buffer ends,
+// the buffer is restarted with the next backup channel. The clients keep reading from the same broadcaster, the HTTP response stays open.
+func Start(streamID int, playlistID string) {
+
+	p, ok := config.BufferInformation.Load(playlistID)
+	if !ok {
+		return
+	}
+
+	var playlist = p.(structs.Playlist)
+	var stream = playlist.Streams[streamID]
+	var key = playlistID + stream.MD5
+
+	broadcaster, ok := GetBroadcaster(key)
+	if !ok {
+		return
+	}
+
+	var urls = getStreamURLs(stream)
+	var err error
+
+	// Provider with several accounts: credentials of the account of the stream, the backup channels already have the accounts of their playlists
+	urls[0] = AccountURL(urls[0], playlist.Accounts, stream.Account)
+
+	for i, url := range urls {
+
+		if i > 0 {
+			cli.ShowHighlight(fmt.Sprintf("START OF BACKUP %d STREAM", i))
+			cli.ShowInfo(fmt.Sprintf("Backup Channel %d URL: %s", i, url))
+		}
+
+		// Stalker portals: the link of the stream is created when the buffer connects, the clients share the buffer of the playlist URL
+		if url, err = stalker.Resolve(url); err != nil {
+			cli.ShowError(err, 0)
+			continue
+		}
+
+		err = runBuffer(playlist.Buffer, streamID, playlistID, url, i < len(urls)-1, broadcaster)
+
+		if errors.Is(err, errClientDisconnected) || !client.Connection(stream) {
+			return
+		}
+
+		if i == 0 {
+			countAccountError(playlist, stream, err)
+		}
+
+		if i < len(urls)-1 {
+			cli.ShowError(err, 4008)
+		}
+
+	}
+
+	// No (further) backup channel: the clients see a slate and are disconnected
+	cli.ShowError(err, 4009)
+	showSlate(playlistID, streamID, stream, broadcaster, errorSlate(err))
+
+	config.Lock.Lock()
+	if c, ok := config.BufferClients.Load(key); ok {
+
+		var clients = c.(structs.ClientConnection)
+		clients.Error = err
+		config.BufferClients.Store(key, clients)
+
+	}
+	config.Lock.Unlock()
+
+}
+
+// runBuffer : Runs the buffer with one streaming URL until it ends or the watchdog stops it. backup: there is another backup channel.
+func runBuffer(bufferType string, streamID int, playlistID string, url string, backup bool, broadcaster *Broadcaster) (err error) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// New source, the analyzer starts again with the continuity counters and the program tables
+	broadcaster.analyzer.discontinuity()
+
+	var failed = make(chan error, 1)
+	go watchdog(ctx, cancel, broadcaster, backup, failed)
+
+	b, ok := bufferTypes[bufferType]
+	if !ok {
+		return errClientDisconnected
+	}
+
+	err = b.Run(ctx, streamID, playlistID, url, broadcaster)
+
+	select {
+	case err = <-failed:
+	default:
+		if err == nil {
+			err = errStreamEnded
+		}
+	}
+
+	return
+}
+
+// watchdog : Cancels the buffer if no new data has been written into the broadcaster within the stall timeout (20 seconds until the first data arrives).
+// A frozen or corrupted stream is only canceled if there is a backup channel, otherwise the clients keep the stream.
+func watchdog(ctx context.Context, cancel context.CancelFunc, broadcaster *Broadcaster, backup bool, failed chan<- error) {
+
+	var ticker = time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var start = broadcaster.Written()
+	var last = start
+	var lastData = time.Now()
+
+	var stop = func(err error) {
+		failed <- err
+		cancel()
+	}
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+
+			var written = broadcaster.Written()
+			if written != last {
+
+				last = written
+				lastData = time.Now()
+
+				switch health := broadcaster.Health(); {
+
+				case health.Status == HealthFrozen && backup:
+					stop(errStreamFrozen)
+					return
+
+				case health.Status == HealthCorrupt && backup:
+					stop(errStreamCorrupted)
+					return
+
+				}
+
+				continue
+			}
+
+			var timeout = stallTimeout()
+			if written == start {
+				timeout = 20 * time.Second
+			}
+
+			if time.Since(lastData) >= timeout {
+				stop(errStreamStalled)
+				return
+			}
+
+		}
+
+	}
+
+}
+
+// stallTimeout : Time without new data (or video progress) after which a stream is stalled (settings: buffer.stall.timeout, default 20 seconds)
+func stallTimeout() time.Duration {
+
+	var timeout = time.Duration(config.Settings.BufferStallTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = 20 * time.Second
+	}
+
+	return timeout
+}
+
+// errorSlate : Slate for the error of the buffer
+func errorSlate(err error) string {
+
+	var statusErr httpStatusError
+
+	switch {
+
+	case errors.As(err, &statusErr) && (statusErr.code == http.StatusUnauthorized || statusErr.code == http.StatusForbidden):
+		return slate.Unauthorized
+
+	case errors.Is(err, errStreamStalled), errors.Is(err, errStreamEnded), errors.Is(err, errStreamFrozen):
+		return slate.Offline
+
+	}
+
+	return slate.ProviderError
+}
+
+// showSlate : Writes the slate into the broadcaster of the stream until the duration has expired or no client is connected anymore
+func showSlate(playlistID string, streamID int, stream structs.ThisStream, broadcaster *Broadcaster, name string) {
+
+	if _, err := slate.Get(name); err != nil {
+		return
+	}
+
+	cli.ShowInfo(fmt.Sprintf("Streaming Status:Channel: %s - Slate: %s", stream.ChannelName, name))
+
+	ctx, cancel := context.WithTimeout(context.Background(), slate.Duration)
+	defer cancel()
+
+	go func() {
+
+		var ticker = time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, ok := config.BufferClients.Load(playlistID + stream.MD5); !ok {
+					cancel()
+					return
+				}
+			}
+		}
+
+	}()
+
+	// Clients that are still waiting for the first segment receive the slate as well
+	setStreamStatus(playlistID, streamID, true)
+
+	_ = slate.Write(ctx, broadcaster, name, slate.Duration)
+
+}
@@ -0,0 +1,324 @@
+package channels
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	jsonserializer "threadfin/internal/json-serializer"
+	"threadfin/internal/provider"
+	"threadfin/internal/structs"
+	"threadfin/internal/utilities"
+)
+
+// Rules for the primary channel of a channel group (settings: channel.groups.rules)
+const (
+	RuleResolution   = "resolution"   // Resolution of the last probe
+	RulePriority     = "priority"     // Priority of the provider (playlist: priority)
+	RuleAvailability = "availability" // Successful background probes in percent
+)
+
+var (
+	countryPrefix = regexp.MustCompile(`^\s*(\|[a-z]{2,3}\||[a-z]{2,3}\s*[:|])\s*`)
+	nameNumber    = regexp.MustCompile(`\(\d+\)`)
+	qualityTokens = regexp.MustCompile(`\b(sd|hd|fhd|uhd|4k|8k|hevc|h\.?264|h\.?265|\d{3,4}[pi]|\d{2,3}\s?fps|fps)\b`)
+	nonAlphaNum   = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+)
+
+type groupMember struct {
+	id           string
+	channel      structs.XEPGChannelStruct
+	resolution   int
+	priority     int
+	availability float64 // -1: not probed
+}
+
+// Group : Groups the active channels with the same tvg-id or name (settings: channel.groups).
+// The best channel of a group is the primary channel, the other channels are hidden and used as ordered backup streams of the primary channel.
+// Without channel.groups the hidden channels are shown again. availability: Successful probes in percent (key: XEPG ID).
+// The XEPG channels are changed: Group is called by the XEPG build (ScanInProgress) or with config.XepgMutex locked (background probes).
+func Group(availability map[string]float64) (changed bool) {
+
+	var channels = make(map[string]structs.XEPGChannelStruct)
+	var oldStreams = make(map[string][]structs.BackupStream) // Streams of the previous groups, key: XEPG ID of the primary channel
+
+	for id, dxc := range config.Data.XEPG.Channels {
+
+		var channel structs.XEPGChannelStruct
+		if err := json.Unmarshal([]byte(jsonserializer.MapToJSON(dxc)), &channel); err != nil {
+			continue
+		}
+
+		channels[id] = channel
+
+		if len(channel.XChannelGroup) > 0 {
+			oldStreams[channel.XChannelGroup] = append(oldStreams[channel.XChannelGroup], structs.BackupStream{PlaylistID: channel.FileM3UID, URL: channel.URL})
+		}
+
+	}
+
+	var groups [][]string
+	if config.Settings.ChannelGroups {
+		groups = findGroups(channels)
+	}
+
+	var rules = groupRules(config.Settings.ChannelGroupRules)
+	var result = make(map[string]structs.XEPGChannelStruct)
+
+	for _, group := range groups {
+
+		var members = make([]groupMember, 0, len(group))
+
+		for _, id := range group {
+
+			var member = groupMember{id: id, channel: channels[id], availability: -1}
+			member.resolution = utilities.VideoHeight(member.channel.XResolution)
+			member.priority, _ = strconv.Atoi(provider.GetProviderParameter(member.channel.FileM3UID, "m3u", "priority"))
+
+			if value, ok := availability[id]; ok {
+				member.availability = value
+			}
+
+			members = append(members, member)
+
+		}
+
+		rankMembers(members, rules)
+
+		var primary = members[0]
+		var streams = make([]structs.BackupStream, 0, len(members)-1)
+
+		for _, member := range members[1:] {
+
+			var channel = member.channel
+			channel.XChannelGroup = primary.id
+			channel.XGroupBackup = true
+			channel.XHideChannel = true
+			channel.BackupChannels = removeStreams(channel.BackupChannels, oldStreams[member.id])
+
+			result[member.id] = channel
+			streams = append(streams, structs.BackupStream{PlaylistID: channel.FileM3UID, URL: channel.URL})
+
+		}
+
+		var channel = primary.channel
+
+		if channel.XGroupBackup {
+			channel.XHideChannel = false
+		}
+
+		channel.XChannelGroup = primary.id
+		channel.XGroupBackup = false
+		// Backup streams of the group first, then the backup channels of the mapping
+		channel.BackupChannels = append(streams, removeStreams(removeStreams(channel.BackupChannels, oldStreams[primary.id]), streams)...)
+
+		result[primary.id] = channel
+
+	}
+
+	// Channels that are no longer part of a group
+	for id, channel := range channels {
+
+		if _, ok := result[id]; ok || len(channel.XChannelGroup) == 0 {
+			continue
+		}
+
+		if channel.XGroupBackup {
+			channel.XHideChannel = false
+		}
+
+		channel.BackupChannels = removeStreams(channel.BackupChannels, oldStreams[id])
+		channel.XChannelGroup = ""
+		channel.XGroupBackup = false
+
+		result[id] = channel
+
+	}
+
+	for id, channel := range result {
+
+		if jsonserializer.MapToJSON(channels[id]) == jsonserializer.MapToJSON(channel) {
+			continue
+		}
+
+		config.Data.XEPG.Channels[id] = channel
+		changed = true
+
+	}
+
+	if len(groups) > 0 {
+		cli.ShowInfo(fmt.Sprintf("XEPG:Channel groups: %d", len(groups)))
+	}
+
+	return
+}
+
+// findGroups : Active channels with the same tvg-id or normalized name, only groups with more than one channel.
+// Channels hidden in the mapping are not grouped, the channels hidden by a group are.
+func findGroups(channels map[string]structs.XEPGChannelStruct) (groups [][]string) {
+
+	var ids = make([]string, 0, len(channels))
+	for id, channel := range channels {
+
+		if channel.XActive && (!channel.XHideChannel || channel.XGroupBackup) {
+			ids = append(ids, id)
+		}
+
+	}
+
+	sort.Strings(ids)
+
+	var parent = make(map[string]string, len(ids))
+	var find func(id string) string
+
+	find = func(id string) string {
+
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+
+		return parent[id]
+	}
+
+	var keys = make(map[string]string)
+
+	for _, id := range ids {
+
+		parent[id] = id
+
+		var channel = channels[id]
+		var name = channel.XName
+		if len(name) == 0 {
+			name = channel.Name
+		}
+
+		for _, key := range []string{"tvg-id:" + strings.ToLower(strings.TrimSpace(channel.TvgID)), "name:" + normalizeName(name)} {
+
+			if strings.HasSuffix(key, ":") {
+				continue
+			}
+
+			if other, ok := keys[key]; ok {
+				parent[find(id)] = find(other)
+			} else {
+				keys[key] = id
+			}
+
+		}
+
+	}
+
+	var members = make(map[string][]string)
+	for _, id := range ids {
+		members[find(id)] = append(members[find(id)], id)
+	}
+
+	for _, id := range ids {
+
+		if group := members[id]; len(group) > 1 {
+			groups = append(groups, group)
+		}
+
+	}
+
+	return
+}
+
+// normalizeName : Channel name without country prefix, quality (e.g. HD, 1080p), numbering of the provider and special characters.
+// Example: "DE: Das Erste HD (2)" -> "daserste"
+func normalizeName(name string) string {
+
+	name = strings.ToLower(name)
+	name = countryPrefix.ReplaceAllString(name, "")
+	name = nameNumber.ReplaceAllString(name, "")
+	name = qualityTokens.ReplaceAllString(name, "")
+	name = nonAlphaNum.ReplaceAllString(name, "")
+
+	return name
+}
+
+// groupRules : Valid rules of the setting in the given order, the default order is used for an empty setting
+func groupRules(setting string) (rules []string) {
+
+	for _, rule := range strings.Split(setting, ",") {
+
+		rule = strings.TrimSpace(rule)
+
+		switch rule {
+
+		case RuleResolution, RulePriority, RuleAvailability:
+			if !slices.Contains(rules, rule) {
+				rules = append(rules, rule)
+			}
+
+		}
+
+	}
+
+	if len(rules) == 0 {
+		rules = []string{RuleResolution, RulePriority, RuleAvailability}
+	}
+
+	return
+}
+
+// rankMembers : Best channel first. The first rule that differs decides, then the current primary channel and the XEPG ID.
+func rankMembers(members []groupMember, rules []string) {
+
+	sort.SliceStable(members, func(i, j int) bool {
+
+		var a, b = members[i], members[j]
+
+		for _, rule := range rules {
+
+			switch rule {
+
+			case RuleResolution:
+				if a.resolution != b.resolution {
+					return a.resolution > b.resolution
+				}
+
+			case RulePriority:
+				if a.priority != b.priority {
+					return a.priority > b.priority
+				}
+
+			case RuleAvailability:
+				if a.availability != b.availability {
+					return a.availability > b.availability
+				}
+
+			}
+
+		}
+
+		var aPrimary = a.channel.XChannelGroup == a.id
+		var bPrimary = b.channel.XChannelGroup == b.id
+
+		if aPrimary != bPrimary {
+			return aPrimary
+		}
+
+		return a.id < b.id
+	})
+
+}
+
+// removeStreams : Backup streams without the given streams
+func removeStreams(backups, streams []structs.BackupStream) (result []structs.BackupStream) {
+
+	for _, backup := range backups {
+
+		if !slices.Contains(streams, backup) {
+			result = append(result, backup)
+		}
+
+	}
+
+	return
+}
@@ -0,0 +1,128 @@
+package channels
+
+import (
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+)
+
+func TestNormalizeName(t *testing.T) {
+
+	var names = map[string]string{
+		"DE: Das Erste HD":      "daserste",
+		"|DE| Das Erste FHD":    "daserste",
+		"Das Erste (2)":         "daserste",
+		"Das Erste 1080p HEVC":  "daserste",
+		"Sky Sport 1 HD":        "skysport1",
+		"Sky Sport 2 HD":        "skysport2",
+		"RTL II":                "rtlii",
+		"ProSieben UHD 50 fps":  "prosieben",
+		"ZDF h.264":             "zdf",
+		"Nickelodeon / Comedy":  "nickelodeoncomedy",
+		"Hidden Channel (test)": "hiddenchanneltest",
+	}
+
+	for name, expected := range names {
+
+		if normalized := normalizeName(name); normalized != expected {
+			t.Errorf("%q: expected %q, got %q", name, expected, normalized)
+		}
+
+	}
+
+}
+
+func TestGroup(t *testing.T) {
+
+	config.Settings.ChannelGroups = true
+	config.Settings.ChannelGroupRules = "resolution,priority,availability"
+	config.Settings.Files.M3U = map[string]any{
+		"M1": map[string]any{"name": "Provider 1", "priority": float64(1)},
+		"M2": map[string]any{"name": "Provider 2", "priority": float64(2)},
+	}
+	config.Data.XEPG.Channels = map[string]any{
+		"x-ID.1": structs.XEPGChannelStruct{XName: "Das Erste SD", TvgID: "daserste.de", XActive: true, FileM3UID: "M1", URL: "http://1/sd", XResolution: "576p"},
+		"x-ID.2": structs.XEPGChannelStruct{XName: "Das Erste HD", XActive: true, FileM3UID: "M2", URL: "http://2/hd", XResolution: "720p"},
+		"x-ID.3": structs.XEPGChannelStruct{XName: "DE: Das Erste FHD", TvgID: "daserste.de", XActive: true, FileM3UID: "M1", URL: "http://1/fhd", XResolution: "1080p", BackupChannels: []structs.BackupStream{{PlaylistID: "M2", URL: "http://2/other"}}},
+		"x-ID.4": structs.XEPGChannelStruct{XName: "Das Erste", XActive: true, XHideChannel: true, FileM3UID: "M2", URL: "http://2/hidden"},
+		"x-ID.5": structs.XEPGChannelStruct{XName: "ZDF HD", XActive: true, FileM3UID: "M1", URL: "http://1/zdf"},
+	}
+	defer func() {
+		config.Settings.ChannelGroups = false
+		config.Settings.ChannelGroupRules = ""
+		config.Settings.Files.M3U = nil
+		config.Data.XEPG.Channels = nil
+	}()
+
+	var channel = func(id string) structs.XEPGChannelStruct {
+		return config.Data.XEPG.Channels[id].(structs.XEPGChannelStruct)
+	}
+
+	if !Group(nil) {
+		t.Fatal("channels not grouped")
+	}
+
+	// FHD is the primary channel, the backup streams are ordered by the resolution
+	var primary = channel("x-ID.3")
+	var expected = []structs.BackupStream{{PlaylistID: "M2", URL: "http://2/hd"}, {PlaylistID: "M1", URL: "http://1/sd"}, {PlaylistID: "M2", URL: "http://2/other"}}
+
+	if primary.XHideChannel || primary.XGroupBackup || primary.XChannelGroup != "x-ID.3" || len(primary.BackupChannels) != len(expected) {
+		t.Fatalf("unexpected primary channel: %+v", primary)
+	}
+
+	for i := range expected {
+		if primary.BackupChannels[i] != expected[i] {
+			t.Errorf("backup %d: expected %+v, got %+v", i, expected[i], primary.BackupChannels[i])
+		}
+	}
+
+	for _, id := range []string{"x-ID.1", "x-ID.2"} {
+		if c := channel(id); !c.XHideChannel || !c.XGroupBackup || c.XChannelGroup != "x-ID.3" {
+			t.Errorf("%s: unexpected backup channel: %+v", id, c)
+		}
+	}
+
+	// Hidden in the mapping and without duplicates
+	for _, id := range []string{"x-ID.4", "x-ID.5"} {
+		if c := channel(id); len(c.XChannelGroup) > 0 {
+			t.Errorf("%s: unexpected group: %+v", id, c)
+		}
+	}
+
+	if Group(nil) {
+		t.Error("unchanged groups")
+	}
+
+	// Provider priority first: HD of provider 2 becomes the primary channel
+	config.Settings.ChannelGroupRules = "priority,resolution"
+
+	if !Group(nil) {
+		t.Fatal("primary channel not changed")
+	}
+
+	primary = channel("x-ID.2")
+	expected = []structs.BackupStream{{PlaylistID: "M1", URL: "http://1/fhd"}, {PlaylistID: "M1", URL: "http://1/sd"}}
+
+	if primary.XHideChannel || primary.XGroupBackup || len(primary.BackupChannels) != len(expected) {
+		t.Fatalf("unexpected primary channel: %+v", primary)
+	}
+
+	if c := channel("x-ID.3"); !c.XHideChannel || len(c.BackupChannels) != 1 {
+		t.Errorf("x-ID.3: unexpected backup channel: %+v", c)
+	}
+
+	// Disabled: the channels are shown again
+	config.Settings.ChannelGroups = false
+	Group(nil)
+
+	for _, id := range []string{"x-ID.1", "x-ID.2", "x-ID.3"} {
+		if c := channel(id); c.XHideChannel || c.XGroupBackup || len(c.XChannelGroup) > 0 {
+			t.Errorf("%s: unexpected channel: %+v", id, c)
+		}
+	}
+
+	if c := channel("x-ID.4"); !c.XHideChannel {
+		t.Error("x-ID.4: channel hidden in the mapping is shown")
+	}
+
+}
@@ -308,6 +308,8 @@ func GetErrMsg(errCode int) (errMsg string) {
 		errMsg = "FFmpeg binary was not found. Check the FFmpeg binary path in the Threadfin settings."
 	case 2021:
 		errMsg = "VLC binary was not found. Check the VLC path binary in the Threadfin settings."
+	case 2022:
+		errMsg = "Command binary was not found. Check the command path in the Threadfin settings."
 
 	case 2098:
 		errMsg = "Updates are disabled in the settings"
@@ -353,6 +355,22 @@ func GetErrMsg(errCode int) (errMsg string) {
 		errMsg = "Server connection timeout"
 	case 4007:
 		errMsg = "Old temporary buffer file could not be deleted"
+	case 4008:
+		errMsg = "No data from the streaming server, the buffer is restarted with the next backup channel"
+	case 4009:
+		errMsg = "Stream has been interrupted and there is no (further) backup channel"
+	case 4010:
+		errMsg = "Buffer profile does not exist"
+	case 4011:
+		errMsg = "Stream session does not exist"
+	case 4012:
+		errMsg = "Slate does not exist"
+	case 4013:
+		errMsg = "Slate is not an MPEG-TS file"
+	case 4014:
+		errMsg = "Channel is not active, the preview is only available for active channels"
+	case 4015:
+		errMsg = "Slate is too large (max. 20 MB)"
 
 	// Buffer (M3U8)
 	case 4050:
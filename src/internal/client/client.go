@@ -2,10 +2,12 @@ package client
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"threadfin/internal/cli"
 	"threadfin/internal/config"
+	"threadfin/internal/storage"
 	"threadfin/internal/structs"
 )
 
@@ -21,10 +23,12 @@ func Connection(stream structs.ThisStream) (status bool) {
 
 		status = false
 
+		closeBroadcaster(stream.PlaylistID + stream.MD5)
+
 		debug = fmt.Sprintf("Remove tmp folder:%s", stream.Folder)
 		cli.ShowDebug(debug, 1)
 
-		if err := config.BufferVFS.RemoveAll(stream.Folder); err != nil {
+		if err := storage.RemoveVFSFolder(stream.Folder, config.System.Folder.Temp, config.BufferVFS); err != nil {
 			cli.ShowError(err, 4005)
 		}
 
@@ -59,7 +63,12 @@ func KillConnection(streamID int, playlistID string, force bool) {
 		var playlist = p.(structs.Playlist)
 
 		if force {
+			if stream, ok := playlist.Streams[streamID]; ok {
+				config.BufferClients.Delete(playlistID + stream.MD5)
+				closeBroadcaster(playlistID + stream.MD5)
+			}
 			delete(playlist.Streams, streamID)
+			delete(playlist.Clients, streamID)
 			if len(playlist.Streams) == 0 {
 				config.BufferInformation.Delete(playlistID)
 			} else {
@@ -92,6 +101,7 @@ func KillConnection(streamID int, playlistID string, force bool) {
 
 				if clients.Connection <= 0 {
 					config.BufferClients.Delete(playlistID + stream.MD5)
+					closeBroadcaster(playlistID + stream.MD5)
 					delete(playlist.Streams, streamID)
 					delete(playlist.Clients, streamID)
 
@@ -112,6 +122,17 @@ func KillConnection(streamID int, playlistID string, force bool) {
 	}
 }
 
+// closeBroadcaster : Stops the broadcaster (ring buffer) of the stream, all clients of the stream receive EOF
+func closeBroadcaster(key string) {
+
+	if b, ok := config.BufferBroadcasters.LoadAndDelete(key); ok {
+		if c, ok := b.(io.Closer); ok {
+			_ = c.Close()
+		}
+	}
+
+}
+
 func GetIP(r *http.Request) string {
 	// Check the X-Forwarded-For header first
 	forwarded := r.Header.Get("X-Forwarded-For")
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+	"time"
+)
+
+// GetPriority : Priority of the request for the tuner preemption (settings: stream.priorities). Without a matching rule the priority is 0.
+// The user has to be verified by the stream authentication (User), without a user the rules with a user condition do not match.
+func GetPriority(r *http.Request, user, channelName string) (priority int) {
+
+	var ip = GetIP(r)
+	var matched = false
+
+	for _, rule := range config.Settings.StreamPriorities {
+
+		if !matchPriority(rule, ip, r.UserAgent(), user, channelName) {
+			continue
+		}
+
+		if !matched || rule.Priority > priority {
+			priority = rule.Priority
+			matched = true
+		}
+
+	}
+
+	return
+}
+
+// matchPriority : All conditions of the rule that are set must match
+func matchPriority(rule structs.StreamPriority, ip, userAgent, user, channelName string) bool {
+
+	if len(rule.User) > 0 && (len(user) == 0 || rule.User != user) {
+		return false
+	}
+
+	if len(rule.IP) > 0 && !matchIP(rule.IP, ip) {
+		return false
+	}
+
+	if len(rule.UserAgent) > 0 && !strings.Contains(strings.ToLower(userAgent), strings.ToLower(rule.UserAgent)) {
+		return false
+	}
+
+	if len(rule.Channel) > 0 && !strings.EqualFold(rule.Channel, channelName) {
+		return false
+	}
+
+	return true
+}
+
+// matchIP : IP address or range (CIDR)
+func matchIP(rule, ip string) bool {
+
+	var address = net.ParseIP(ip)
+	if address == nil {
+		return false
+	}
+
+	if _, network, err := net.ParseCIDR(rule); err == nil {
+		return network.Contains(address)
+	}
+
+	return address.Equal(net.ParseIP(rule))
+}
+
+// PreemptStream : Stops the running stream of the playlists (tuner pool) with the lowest priority, if it is lower than the priority of the new request.
+// The clients of the stream receive the tuner limit slate. Returns true if a tuner has been released.
+func PreemptStream(playlistIDs []string, priority int) bool {
+
+	type streamPriority struct {
+		playlistID string
+		streamID   int
+		priority   int
+		start      time.Time
+		sessions   []*Session
+	}
+
+	var streams = make(map[string]*streamPriority)
+
+	// The priority of a stream is the highest priority of its clients
+	config.StreamSessions.Range(func(_, value any) bool {
+
+		if s, ok := value.(*Session); ok {
+
+			var info = s.Info()
+			if !slices.Contains(playlistIDs, info.PlaylistID) {
+				return true
+			}
+
+			var key = fmt.Sprintf("%s-%d", info.PlaylistID, info.StreamID)
+
+			stream, ok := streams[key]
+			if !ok {
+				stream = &streamPriority{playlistID: info.PlaylistID, streamID: info.StreamID, priority: info.Priority, start: info.Start}
+				streams[key] = stream
+			}
+
+			stream.priority = max(stream.priority, info.Priority)
+			if info.Start.Before(stream.start) {
+				stream.start = info.Start
+			}
+
+			stream.sessions = append(stream.sessions, s)
+		}
+
+		return true
+	})
+
+	var stream *streamPriority
+
+	for _, s := range streams {
+
+		if s.priority >= priority {
+			continue
+		}
+
+		// Same priority: the stream that has been started last is stopped
+		if stream == nil || s.priority < stream.priority || (s.priority == stream.priority && s.start.After(stream.start)) {
+			stream = s
+		}
+
+	}
+
+	if stream == nil {
+		return false
+	}
+
+	for _, s := range stream.sessions {
+
+		var info = s.Info()
+		cli.ShowInfo(fmt.Sprintf("Streaming Status:Channel: %s - Client %s has been preempted by a request with a higher priority (%d > %d)", info.ChannelName, info.IP, priority, stream.priority))
+
+		s.preempted.Store(true)
+		s.cancel()
+
+	}
+
+	KillConnection(stream.streamID, stream.playlistID, true)
+
+	return true
+}
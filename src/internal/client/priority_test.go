@@ -0,0 +1,115 @@
+package client
+
+import (
+	"net/http/httptest"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+)
+
+func TestGetPriority(t *testing.T) {
+
+	config.Settings.StreamPriorities = []structs.StreamPriority{
+		{UserAgent: "plex media server", Priority: 10},
+		{IP: "10.0.0.0/8", Priority: 5},
+		{IP: "10.0.0.1", Channel: "News", Priority: -1},
+		{User: "kids", Priority: -5},
+	}
+	defer func() { config.Settings.StreamPriorities = nil }()
+
+	var tests = []struct {
+		url, user, ip, userAgent, channel string
+		priority                          int
+	}{
+		{"/stream/abc", "", "192.168.1.10", "VLC/3.0", "News", 0},
+		{"/stream/abc", "", "192.168.1.10", "Plex Media Server/1.40", "News", 10},
+		{"/stream/abc", "", "10.1.2.3", "VLC/3.0", "News", 5},
+		{"/stream/abc", "kids", "192.168.1.10", "VLC/3.0", "News", -5},
+		{"/stream/abc", "kids", "10.1.2.3", "VLC/3.0", "News", 5},
+	}
+
+	for _, test := range tests {
+
+		var r = httptest.NewRequest("GET", test.url, nil)
+		r.RemoteAddr = test.ip + ":50000"
+		r.Header.Set("User-Agent", test.userAgent)
+
+		if priority := GetPriority(r, test.user, test.channel); priority != test.priority {
+			t.Errorf("%s %s %s %s: expected priority %d, got %d", test.url, test.user, test.ip, test.userAgent, test.priority, priority)
+		}
+
+	}
+
+	// The user of the URL and the HTTP basic authentication have not been verified
+	var spoofed = httptest.NewRequest("GET", "/stream/abc?username=kids", nil)
+	spoofed.SetBasicAuth("kids", "")
+	spoofed.RemoteAddr = "192.168.1.10:50000"
+
+	if priority := GetPriority(spoofed, User(spoofed), "News"); priority != 0 {
+		t.Errorf("unverified user matched a rule: priority %d", priority)
+	}
+
+	if priority := GetPriority(spoofed, User(WithUser(spoofed, "kids")), "News"); priority != -5 {
+		t.Errorf("expected priority -5 for the verified user, got %d", priority)
+	}
+
+	// Only the matching rule with the channel condition
+	config.Settings.StreamPriorities = config.Settings.StreamPriorities[2:3]
+
+	var r = httptest.NewRequest("GET", "/stream/abc", nil)
+	r.RemoteAddr = "10.0.0.1:50000"
+
+	if priority := GetPriority(r, "", "news"); priority != -1 {
+		t.Errorf("expected priority -1, got %d", priority)
+	}
+
+}
+
+func TestPreemptStream(t *testing.T) {
+
+	config.Settings.StreamPriorities = []structs.StreamPriority{{User: "dvr", Priority: 10}}
+	defer func() { config.Settings.StreamPriorities = nil }()
+
+	var playlist = structs.Playlist{
+		PlaylistID: "M-test",
+		Streams: map[int]structs.ThisStream{
+			0: {PlaylistID: "M-test", MD5: "md5-0", ChannelName: "One"},
+			1: {PlaylistID: "M-test", MD5: "md5-1", ChannelName: "Two"},
+		},
+		Clients: map[int]structs.ThisClient{0: {Connection: 1}, 1: {Connection: 1}},
+	}
+
+	config.BufferInformation.Store(playlist.PlaylistID, playlist)
+	defer config.BufferInformation.Delete(playlist.PlaylistID)
+
+	recording, _ := NewSession(httptest.NewRequest("GET", "/stream/abc", nil), "dvr", "M-test", 0, "One")
+	defer recording.Close()
+
+	viewer, ctx := NewSession(httptest.NewRequest("GET", "/stream/def?username=dvr", nil), "", "M-test", 1, "Two")
+	defer viewer.Close()
+
+	// Same priority as the viewer: no tuner is released
+	if PreemptStream([]string{"M-test"}, 0) {
+		t.Fatal("stream with the same priority has been preempted")
+	}
+
+	if !PreemptStream([]string{"M-test"}, 5) {
+		t.Fatal("no stream has been preempted")
+	}
+
+	if ctx.Err() == nil || !viewer.Preempted() || recording.Preempted() {
+		t.Error("the wrong session has been preempted")
+	}
+
+	p, _ := config.BufferInformation.Load("M-test")
+	if streams := p.(structs.Playlist).Streams; len(streams) != 1 || streams[0].ChannelName != "One" {
+		t.Errorf("unexpected streams: %v", streams)
+	}
+
+	// The recording has a higher priority
+	viewer.Close()
+	if PreemptStream([]string{"M-test"}, 5) {
+		t.Error("stream with a higher priority has been preempted")
+	}
+
+}
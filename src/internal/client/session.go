@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+	"time"
+)
+
+// Session : Client of a stream in the session registry (config.StreamSessions)
+type Session struct {
+	mu        sync.Mutex
+	info      structs.StreamSession
+	lastBytes int64
+	lastTime  time.Time
+	cancel    context.CancelFunc
+	preempted atomic.Bool
+}
+
+var sessionCounter atomic.Int64
+
+// bitrateInterval : Bytes sent within this interval are used for the current bitrate
+const bitrateInterval = 2 * time.Second
+
+// NewSession : Registers the client of a stream. The context ends with the HTTP request or when the session is terminated.
+// The user has been verified by the stream authentication (User).
+func NewSession(r *http.Request, user, playlistID string, streamID int, channelName string) (s *Session, ctx context.Context) {
+
+	ctx, cancel := context.WithCancel(r.Context())
+
+	var now = time.Now()
+
+	s = &Session{
+		info: structs.StreamSession{
+			ID:          strconv.FormatInt(sessionCounter.Add(1), 10),
+			PlaylistID:  playlistID,
+			StreamID:    streamID,
+			ChannelName: channelName,
+			IP:          GetIP(r),
+			UserAgent:   r.Header.Get("User-Agent"),
+			User:        user,
+			Start:       now,
+			Priority:    GetPriority(r, user, channelName),
+		},
+		lastTime: now,
+		cancel:   cancel,
+	}
+
+	config.StreamSessions.Store(s.info.ID, s)
+
+	cli.ShowDebug(fmt.Sprintf("Streaming Status:New session %s (%s, %s)", s.info.ID, s.info.IP, s.info.UserAgent), 1)
+
+	return
+}
+
+// AddBytes : Counts the data that has been sent to the client
+func (s *Session) AddBytes(n int) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.info.BytesSent += int64(n)
+
+	var now = time.Now()
+	if elapsed := now.Sub(s.lastTime); elapsed >= bitrateInterval {
+		s.info.Bitrate = (s.info.BytesSent - s.lastBytes) * 8 * int64(time.Second) / int64(elapsed)
+		s.lastBytes = s.info.BytesSent
+		s.lastTime = now
+	}
+
+}
+
+// Close : Removes the session from the registry
+func (s *Session) Close() {
+	s.cancel()
+	config.StreamSessions.Delete(s.info.ID)
+}
+
+// Preempted : The stream of the session has been stopped for a request with a higher priority (PreemptStream)
+func (s *Session) Preempted() bool {
+	return s.preempted.Load()
+}
+
+// Info : Current values of the session
+func (s *Session) Info() (info structs.StreamSession) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info = s.info
+
+	// No data has been sent within the interval (e.g. waiting for the buffer)
+	if time.Since(s.lastTime) > 2*bitrateInterval {
+		info.Bitrate = 0
+	}
+
+	info.Health = getHealth(info.PlaylistID, info.StreamID)
+
+	return
+}
+
+// getHealth : MPEG-TS metrics of the stream, analyzed by the broadcaster of the buffer
+func getHealth(playlistID string, streamID int) *structs.StreamHealth {
+
+	p, ok := config.BufferInformation.Load(playlistID)
+	if !ok {
+		return nil
+	}
+
+	stream, ok := p.(structs.Playlist).Streams[streamID]
+	if !ok {
+		return nil
+	}
+
+	if b, ok := config.BufferBroadcasters.Load(playlistID + stream.MD5); ok {
+		if analyzer, ok := b.(interface{ Health() structs.StreamHealth }); ok {
+			var health = analyzer.Health()
+			return &health
+		}
+	}
+
+	return nil
+}
+
+// GetSessions : All active sessions, sorted by start time
+func GetSessions() (sessions []structs.StreamSession) {
+
+	sessions = []structs.StreamSession{}
+
+	config.StreamSessions.Range(func(_, value any) bool {
+		if s, ok := value.(*Session); ok {
+			sessions = append(sessions, s.Info())
+		}
+		return true
+	})
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Start.Before(sessions[j].Start)
+	})
+
+	return
+}
+
+// TerminateSession : Disconnects the client of the session. If no other client uses the stream, the stream is stopped with KillConnection (force).
+func TerminateSession(id string) (err error) {
+
+	value, ok := config.StreamSessions.Load(id)
+	if !ok {
+		return errors.New(cli.GetErrMsg(4011))
+	}
+
+	var s = value.(*Session)
+	var info = s.Info()
+	var shared = false
+
+	config.StreamSessions.Range(func(_, value any) bool {
+		if other, ok := value.(*Session); ok && other != s {
+			var o = other.Info()
+			if o.PlaylistID == info.PlaylistID && o.StreamID == info.StreamID {
+				shared = true
+				return false
+			}
+		}
+		return true
+	})
+
+	cli.ShowInfo(fmt.Sprintf("Streaming Status:Terminate session %s (Channel: %s, Client: %s)", info.ID, info.ChannelName, info.IP))
+
+	s.Close()
+
+	if !shared {
+		KillConnection(info.StreamID, info.PlaylistID, true)
+	}
+
+	return
+}
+
+// userKey : Context key of the verified user of a request (WithUser)
+type userKey struct{}
+
+// WithUser : Request with the user whose credentials have been verified by the stream authentication (Xtream Codes API)
+func WithUser(r *http.Request, user string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), userKey{}, user))
+}
+
+// User : Verified user of the request, empty if the credentials have not been checked
+func User(r *http.Request) string {
+
+	user, _ := r.Context().Value(userKey{}).(string)
+
+	return user
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"net/http/httptest"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+	"time"
+)
+
+func TestSessions(t *testing.T) {
+
+	var playlist = structs.Playlist{
+		PlaylistID: "M-test",
+		Streams:    map[int]structs.ThisStream{0: {PlaylistID: "M-test", MD5: "md5", ChannelName: "One"}},
+		Clients:    map[int]structs.ThisClient{0: {Connection: 2}},
+	}
+
+	config.BufferInformation.Store(playlist.PlaylistID, playlist)
+	config.BufferClients.Store("M-testmd5", structs.ClientConnection{Connection: 2})
+	defer config.BufferInformation.Delete(playlist.PlaylistID)
+	defer config.BufferClients.Delete("M-testmd5")
+
+	var r = httptest.NewRequest("GET", "/stream/abc", nil)
+	r.RemoteAddr = "192.168.1.10:50000"
+	r.Header.Set("User-Agent", "VLC/3.0")
+
+	first, ctx := NewSession(r, "alice", "M-test", 0, "One")
+	second, _ := NewSession(httptest.NewRequest("GET", "/stream/abc?username=mallory", nil), "", "M-test", 0, "One")
+	defer second.Close()
+
+	// 1 MB within 2 seconds: 4 Mbit/s
+	first.lastTime = time.Now().Add(-2 * time.Second)
+	first.AddBytes(1024 * 1024)
+
+	var sessions = GetSessions()
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(sessions))
+	}
+
+	var info = sessions[0]
+	if info.IP != "192.168.1.10" || info.UserAgent != "VLC/3.0" || info.User != "alice" || info.BytesSent != 1024*1024 {
+		t.Errorf("unexpected session: %+v", info)
+	}
+
+	// The user of the URL has not been verified
+	if sessions[1].User != "" {
+		t.Errorf("unverified user recorded: %q", sessions[1].User)
+	}
+
+	if info.Bitrate < 4000000 || info.Bitrate > 4300000 {
+		t.Errorf("unexpected bitrate: %d", info.Bitrate)
+	}
+
+	// The stream is used by another client and keeps running
+	if err := TerminateSession(info.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if ctx.Err() == nil {
+		t.Error("context of the terminated session has not been canceled")
+	}
+
+	if _, ok := config.BufferInformation.Load("M-test"); !ok {
+		t.Error("stream has been stopped although another client is using it")
+	}
+
+	// Last client: the stream is stopped
+	if err := TerminateSession(sessions[1].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := config.BufferInformation.Load("M-test"); ok {
+		t.Error("stream has not been stopped")
+	}
+
+	if _, ok := config.BufferClients.Load("M-testmd5"); ok {
+		t.Error("clients of the stream have not been removed")
+	}
+
+	if err := TerminateSession(info.ID); err == nil {
+		t.Error("expected error for unknown session")
+	}
+
+}
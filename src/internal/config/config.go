@@ -31,6 +31,15 @@ var BufferVFS avfs.VFS
 // BufferClients : Anzahl der Clients die einen Stream über den Buffer abspielen
 var BufferClients sync.Map
 
+// BufferBroadcasters : Broadcaster (ring buffer) of each stream, the buffer writes the data and every client reads it. Key: playlistID + MD5
+var BufferBroadcasters sync.Map
+
+// StreamSessions : Clients that are playing a stream over the buffer (session registry). Key: session ID
+var StreamSessions sync.Map
+
+// HLSOutputs : HLS output of a stream (/stream/<id>/index.m3u8), all HLS clients share the segments. Key: URL ID + buffer profile
+var HLSOutputs sync.Map
+
 // Lock : Lock Map
 var Lock = sync.RWMutex{}
 
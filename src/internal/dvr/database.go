@@ -17,7 +17,9 @@ import (
 // Datenbank für das DVR System erstellen
 func BuildDatabase() (err error) {
 
+	config.XepgMutex.Lock()
 	config.System.ScanInProgress = 1
+	config.XepgMutex.Unlock()
 
 	config.Data.Streams.All = make([]interface{}, 0, config.System.UnfilteredChannelLimit)
 	config.Data.Streams.Active = make([]interface{}, 0, config.System.UnfilteredChannelLimit)
@@ -194,7 +196,9 @@ func BuildDatabase() (err error) {
 		cli.ShowWarning(2001)
 	}
 
+	config.XepgMutex.Lock()
 	config.System.ScanInProgress = 0
+	config.XepgMutex.Unlock()
 	cli.ShowInfo(fmt.Sprintf("All streams:%d", len(config.Data.Streams.All)))
 	cli.ShowInfo(fmt.Sprintf("Active streams:%d", len(config.Data.Streams.Active)))
 	cli.ShowInfo(fmt.Sprintf("Filter:%d", len(config.Data.Filter)))
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"strings"
 	"threadfin/internal/cli"
 	"threadfin/internal/config"
 	jsonserializer "threadfin/internal/json-serializer"
@@ -109,6 +110,22 @@ func GetLineupStatus() (jsonContent []byte, err error) {
 	return
 }
 
+// lineupCodec : Codec name of the HDHomeRun lineup for the ffprobe codec name (e.g. mpeg2video: MPEG2)
+func lineupCodec(codec string) string {
+
+	switch codec {
+
+	case "mpeg2video":
+		return "MPEG2"
+
+	case "mp2", "mp3":
+		return "MPEG"
+
+	}
+
+	return strings.ToUpper(codec)
+}
+
 func GetLineup() (jsonContent []byte, err error) {
 
 	var lineup structs.Lineup
@@ -143,7 +160,7 @@ func GetLineup() (jsonContent []byte, err error) {
 
 			}
 
-			currentStream.URL, err = stream.CreateURL("DVR", m3uChannel.FileM3UID, currentStream.GuideNumber, m3uChannel.Name, m3uChannel.URL, nil, nil, nil)
+			currentStream.URL, err = stream.CreateURL("DVR", m3uChannel.FileM3UID, currentStream.GuideNumber, m3uChannel.Name, m3uChannel.URL, nil, "")
 			if err == nil {
 				lineup = append(lineup, currentStream)
 			} else {
@@ -165,7 +182,15 @@ func GetLineup() (jsonContent []byte, err error) {
 				var currentStream structs.LineupStream
 				currentStream.GuideName = xepgChannel.XName
 				currentStream.GuideNumber = xepgChannel.XChannelID
-				currentStream.URL, err = stream.CreateURL("DVR", xepgChannel.FileM3UID, xepgChannel.XChannelID, xepgChannel.XName, xepgChannel.URL, xepgChannel.BackupChannel1, xepgChannel.BackupChannel2, xepgChannel.BackupChannel3)
+
+				// Stream metadata of the last probe, like the lineup of an HDHomeRun
+				currentStream.VideoCodec = lineupCodec(xepgChannel.XVideoCodec)
+				currentStream.AudioCodec = lineupCodec(xepgChannel.XAudioCodec)
+				if quality := utilities.VideoQuality(xepgChannel.XResolution); quality == "HDTV" || quality == "UHDTV" {
+					currentStream.HD = 1
+				}
+
+				currentStream.URL, err = stream.CreateURL("DVR", xepgChannel.FileM3UID, xepgChannel.XChannelID, xepgChannel.XName, xepgChannel.URL, xepgChannel.BackupChannels, xepgChannel.XBufferProfile)
 				if err == nil {
 					lineup = append(lineup, currentStream)
 				} else {
@@ -0,0 +1,456 @@
+package hls
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	tsPacketSize = 188
+	clockRate    = 90000   // PTS base
+	wrap         = 1 << 33 // 33 bit timestamps
+)
+
+// SegmentDuration : Target duration of a segment, the segments are cut at the next key frame
+const SegmentDuration = 4 * time.Second
+
+// PlaylistSegments : Number of segments in the playlist (rolling window)
+const PlaylistSegments = 6
+
+// Segment : Part of the MPEG-TS stream, starts with the PAT and the PMT
+type Segment struct {
+	Sequence      int64
+	Duration      float64 // Seconds
+	Discontinuity bool    // The timestamps of the stream start again (e.g. backup channel, slate)
+	Data          []byte
+}
+
+// Segmenter : Cuts the MPEG-TS of a stream into HLS segments. The segments are cut at key frames (random access indicator) of the video,
+// streams without video at the first elementary stream and streams without PMT by time.
+type Segmenter struct {
+	mu   sync.Mutex
+	rest []byte
+
+	segments      []Segment
+	sequence      int64 // Media sequence of the next segment
+	discontinuity int64 // Discontinuity sequence of the first segment
+	notify        chan struct{}
+	ended         bool
+
+	pat, pmt  []byte // Last PAT and PMT packet, each segment starts with them
+	pmtPID    uint16
+	timingPID uint16
+	video     bool
+
+	current      []byte
+	start        int64 // PTS at the start of the current segment, -1: no PTS yet
+	last         int64
+	frame        int64 // PTS interval of the last frame
+	started      time.Time
+	discontinued bool // The current segment follows a discontinuity
+}
+
+// NewSegmenter : Creates the segmenter for a stream
+func NewSegmenter() *Segmenter {
+	return &Segmenter{notify: make(chan struct{}), start: -1, started: time.Now()}
+}
+
+// Write : Appends the data of the stream, incomplete packets are completed with the next write (io.Writer). Data after the end is discarded.
+func (s *Segmenter) Write(data []byte) (n int, err error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n = len(data)
+
+	if s.ended {
+		return
+	}
+
+	if len(s.rest) > 0 {
+
+		var missing = tsPacketSize - len(s.rest)
+		if len(data) < missing {
+			s.rest = append(s.rest, data...)
+			return
+		}
+
+		s.packet(append(s.rest, data[:missing]...))
+		data = data[missing:]
+		s.rest = nil
+
+	}
+
+	for len(data) > 0 {
+
+		// Lost sync: continue with the next sync byte
+		if data[0] != 0x47 {
+			var i = 1
+			for i < len(data) && data[i] != 0x47 {
+				i++
+			}
+			data = data[i:]
+			continue
+		}
+
+		if len(data) < tsPacketSize {
+			s.rest = append([]byte{}, data...)
+			break
+		}
+
+		s.packet(data[:tsPacketSize])
+		data = data[tsPacketSize:]
+
+	}
+
+	return
+}
+
+// End : The stream has ended, the last segment is completed and the playlist gets an end tag
+func (s *Segmenter) End() {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ended {
+		return
+	}
+
+	if len(s.current) > 0 {
+		s.cut(s.duration(s.last))
+	}
+
+	s.ended = true
+	close(s.notify)
+
+}
+
+// Wait : Waits until the playlist contains the given number of segments, the stream has ended or the timeout has expired. Returns false if the playlist is empty.
+func (s *Segmenter) Wait(segments int, timeout time.Duration) bool {
+
+	var timer = time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+
+		s.mu.Lock()
+		var count, ended, notify = len(s.segments), s.ended, s.notify
+		s.mu.Unlock()
+
+		if count >= segments || ended {
+			return count > 0
+		}
+
+		select {
+		case <-notify:
+		case <-timer.C:
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return len(s.segments) > 0
+		}
+
+	}
+
+}
+
+// Segment : Data of the segment with the media sequence number, if it is still in the playlist
+func (s *Segmenter) Segment(sequence int64) (data []byte, ok bool) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, segment := range s.segments {
+		if segment.Sequence == sequence {
+			return segment.Data, true
+		}
+	}
+
+	return nil, false
+}
+
+// Playlist : HLS media playlist of the current segments. query is appended to the segment URIs (e.g. ?profile=).
+func (s *Segmenter) Playlist(query string) string {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var target = SegmentDuration.Seconds()
+	for _, segment := range s.segments {
+		target = math.Max(target, segment.Duration)
+	}
+
+	var sequence = s.sequence
+	if len(s.segments) > 0 {
+		sequence = s.segments[0].Sequence
+	}
+
+	if len(query) > 0 {
+		query = "?" + query
+	}
+
+	var playlist strings.Builder
+
+	playlist.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	playlist.WriteString(fmt.Sprintf("#EXT-X-TARGETDURATION:%d\n", int(math.Ceil(target))))
+	playlist.WriteString(fmt.Sprintf("#EXT-X-MEDIA-SEQUENCE:%d\n", sequence))
+	playlist.WriteString(fmt.Sprintf("#EXT-X-DISCONTINUITY-SEQUENCE:%d\n", s.discontinuity))
+
+	for _, segment := range s.segments {
+
+		if segment.Discontinuity {
+			playlist.WriteString("#EXT-X-DISCONTINUITY\n")
+		}
+
+		playlist.WriteString(fmt.Sprintf("#EXTINF:%.3f,\n%d.ts%s\n", segment.Duration, segment.Sequence, query))
+
+	}
+
+	if s.ended {
+		playlist.WriteString("#EXT-X-ENDLIST\n")
+	}
+
+	return playlist.String()
+}
+
+func (s *Segmenter) packet(p []byte) {
+
+	var pid = uint16(p[1]&0x1f)<<8 | uint16(p[2])
+	var unitStart = p[1]&0x40 != 0
+
+	switch {
+
+	case pid == 0 && unitStart:
+		s.pat = append(s.pat[:0], p...)
+		s.parsePAT(p)
+		return
+
+	case pid == s.pmtPID && pid != 0 && unitStart:
+		s.pmt = append(s.pmt[:0], p...)
+		s.parsePMT(p)
+		return
+
+	case pid == 0, pid == s.pmtPID:
+		return
+
+	}
+
+	if pid == s.timingPID && unitStart {
+
+		if pts, ok := pesPTS(p); ok {
+
+			var keyFrame = !s.video || p[3]&0x20 != 0 && p[4] > 0 && p[5]&0x40 != 0
+			var elapsed = (pts - s.start + wrap) % wrap
+
+			switch {
+
+			case s.start < 0:
+				s.start = pts
+
+			// Timestamps jump (more than 3 target durations): new segment with discontinuity
+			case elapsed > 3*s.target():
+				s.cut(s.duration(s.last))
+				s.start = pts
+				s.discontinued = true
+
+			case keyFrame && elapsed >= s.target(), elapsed >= 2*s.target():
+				s.cut(elapsed)
+				s.start = pts
+
+			}
+
+			if interval := (pts - s.last + wrap) % wrap; interval < clockRate {
+				s.frame = interval
+			}
+
+			s.last = pts
+
+		}
+
+	}
+
+	// Without PMT or PTS the segments are cut by time
+	if s.start < 0 && len(s.current) > 0 && time.Since(s.started) >= SegmentDuration {
+		s.cut(int64(time.Since(s.started).Seconds() * clockRate))
+	}
+
+	if len(s.current) == 0 {
+		s.current = append(s.current, s.pat...)
+		s.current = append(s.current, s.pmt...)
+	}
+
+	s.current = append(s.current, p...)
+
+}
+
+// cut : Completes the current segment, the oldest segment is removed from the playlist
+func (s *Segmenter) cut(duration int64) {
+
+	if len(s.current) == 0 {
+		return
+	}
+
+	s.segments = append(s.segments, Segment{
+		Sequence:      s.sequence,
+		Duration:      float64(duration) / clockRate,
+		Discontinuity: s.discontinued,
+		Data:          s.current,
+	})
+
+	s.sequence++
+	s.current = nil
+	s.started = time.Now()
+	s.discontinued = false
+
+	if len(s.segments) > PlaylistSegments {
+
+		if s.segments[0].Discontinuity {
+			s.discontinuity++
+		}
+
+		s.segments = s.segments[1:]
+
+	}
+
+	close(s.notify)
+	s.notify = make(chan struct{})
+
+}
+
+// duration : Duration of the current segment including the frame of the PTS
+func (s *Segmenter) duration(pts int64) int64 {
+
+	if s.start < 0 {
+		return int64(time.Since(s.started).Seconds() * clockRate)
+	}
+
+	return (pts-s.start+wrap)%wrap + s.frame
+}
+
+func (s *Segmenter) target() int64 {
+	return int64(SegmentDuration.Seconds() * clockRate)
+}
+
+// parsePAT : PMT PID of the first program
+func (s *Segmenter) parsePAT(p []byte) {
+
+	section, ok := psiSection(p, 0x00)
+	if !ok {
+		return
+	}
+
+	for i := 8; i+4 <= len(section)-4; i += 4 {
+
+		// Program 0: NIT
+		if section[i] != 0 || section[i+1] != 0 {
+			s.pmtPID = uint16(section[i+2]&0x1f)<<8 | uint16(section[i+3])
+			return
+		}
+
+	}
+
+}
+
+// parsePMT : The segments are timed by the first video stream, otherwise by the first elementary stream
+func (s *Segmenter) parsePMT(p []byte) {
+
+	section, ok := psiSection(p, 0x02)
+	if !ok || len(section) < 16 {
+		return
+	}
+
+	var timingPID uint16
+	var video bool
+
+	for i := 12 + (int(section[10]&0x0f)<<8 | int(section[11])); i+5 <= len(section)-4; i += 5 + (int(section[i+3]&0x0f)<<8 | int(section[i+4])) {
+
+		var pid = uint16(section[i+1]&0x1f)<<8 | uint16(section[i+2])
+
+		if isVideo(section[i]) {
+			timingPID, video = pid, true
+			break
+		}
+
+		if timingPID == 0 {
+			timingPID = pid
+		}
+
+	}
+
+	if timingPID == s.timingPID {
+		return
+	}
+
+	// Another program (e.g. backup channel): the current segment ends before the new PMT
+	if s.timingPID != 0 {
+		s.cut(s.duration(s.last))
+		s.discontinued = true
+	}
+
+	s.timingPID, s.video = timingPID, video
+	s.start = -1
+
+}
+
+// psiSection : PSI section with the table ID at the start of the payload, the section has to be complete within the packet
+func psiSection(p []byte, tableID byte) (section []byte, ok bool) {
+
+	var payload = payload(p)
+	if len(payload) == 0 || int(payload[0])+1 >= len(payload) {
+		return
+	}
+
+	section = payload[payload[0]+1:]
+	if len(section) < 12 || section[0] != tableID {
+		return nil, false
+	}
+
+	var length = 3 + (int(section[1]&0x0f)<<8 | int(section[2]))
+	if length > len(section) {
+		return nil, false
+	}
+
+	return section[:length], true
+}
+
+// pesPTS : PTS of a PES header at the start of the payload
+func pesPTS(p []byte) (pts int64, ok bool) {
+
+	var data = payload(p)
+	if len(data) < 14 || data[0] != 0 || data[1] != 0 || data[2] != 1 || data[6]&0xc0 != 0x80 || data[7]&0x80 == 0 {
+		return
+	}
+
+	var b = data[9:14]
+
+	return int64(b[0]>>1&0x07)<<30 | int64(b[1])<<22 | int64(b[2]>>1)<<15 | int64(b[3])<<7 | int64(b[4]>>1), true
+}
+
+// payload : Payload of the packet after the adaptation field
+func payload(p []byte) []byte {
+
+	if p[3]&0x10 == 0 {
+		return nil
+	}
+
+	if p[3]&0x20 != 0 {
+		if int(p[4])+5 >= len(p) {
+			return nil
+		}
+		return p[5+int(p[4]):]
+	}
+
+	return p[4:]
+}
+
+func isVideo(streamType byte) bool {
+
+	switch streamType {
+	case 0x01, 0x02, 0x10, 0x1b, 0x24, 0x42, 0xd1, 0xea:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package hls
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testPAT = []byte{0x00, 0x00, 0xb0, 0x0d, 0x00, 0x01, 0xc1, 0x00, 0x00, 0x00, 0x01, 0xe0, 0x20, 0, 0, 0, 0}
+	testPMT = []byte{0x00, 0x02, 0xb0, 0x17, 0x00, 0x01, 0xc1, 0x00, 0x00, 0xe1, 0x00, 0xf0, 0x00, 0x1b, 0xe1, 0x00, 0xf0, 0x00, 0x0f, 0xe1, 0x01, 0xf0, 0x00, 0, 0, 0, 0}
+)
+
+func testPacket(pid uint16, payload []byte) []byte {
+
+	var p = make([]byte, tsPacketSize)
+	p[0], p[1], p[2], p[3] = 0x47, 0x40|byte(pid>>8), byte(pid), 0x10
+
+	for i := copy(p[4:], payload) + 4; i < tsPacketSize; i++ {
+		p[i] = 0xff
+	}
+
+	return p
+}
+
+// testFrame : Video packet with PES header and PTS, key frames have the random access indicator
+func testFrame(pts int64, keyFrame bool) []byte {
+
+	var p = testPacket(0x100, nil)
+	p[3] |= 0x20
+	p[4], p[5] = 1, 0x00
+	if keyFrame {
+		p[5] = 0x40
+	}
+
+	copy(p[6:], []byte{0, 0, 1, 0xe0, 0, 0, 0x80, 0x80, 5,
+		0x21 | byte(pts>>29)&0x0e, byte(pts >> 22), byte(pts>>14) | 0x01, byte(pts >> 7), byte(pts<<1) | 0x01})
+
+	return p
+}
+
+// testStream : 25 frames per second, a key frame every second
+func testStream(start int64, seconds int) (data []byte) {
+
+	data = append(testPacket(0x00, testPAT), testPacket(0x20, testPMT)...)
+
+	for i := 0; i < seconds*25; i++ {
+		data = append(data, testFrame(start+int64(i*3600), i%25 == 0)...)
+	}
+
+	return
+}
+
+func TestSegmenter(t *testing.T) {
+
+	var s = NewSegmenter()
+	var data = testStream(90000, 13)
+
+	// Packets are split over several writes
+	for len(data) > 0 {
+		var n = min(len(data), 1000)
+		_, _ = s.Write(data[:n])
+		data = data[n:]
+	}
+
+	if !s.Wait(3, time.Second) {
+		t.Fatal("no segments")
+	}
+
+	if len(s.segments) != 3 {
+		t.Fatalf("expected 3 segments, got %d", len(s.segments))
+	}
+
+	for i, segment := range s.segments {
+
+		if segment.Sequence != int64(i) || segment.Duration != 4 || segment.Discontinuity {
+			t.Errorf("unexpected segment: %d, %f", segment.Sequence, segment.Duration)
+		}
+
+		// PAT, PMT and a key frame at the start of each segment
+		if !bytes.Equal(segment.Data[:tsPacketSize], testPacket(0x00, testPAT)) || !bytes.Equal(segment.Data[tsPacketSize:2*tsPacketSize], testPacket(0x20, testPMT)) {
+			t.Errorf("segment %d does not start with PAT and PMT", i)
+		}
+
+		if segment.Data[2*tsPacketSize+5] != 0x40 || len(segment.Data) != (2+100)*tsPacketSize {
+			t.Errorf("segment %d does not start with a key frame", i)
+		}
+
+	}
+
+	if _, ok := s.Segment(1); !ok {
+		t.Error("segment 1 not found")
+	}
+
+	// The timestamps start again (backup channel)
+	_, _ = s.Write(testStream(5000, 5))
+	s.End()
+
+	var playlist = s.Playlist("profile=hd")
+
+	for _, line := range []string{"#EXT-X-TARGETDURATION:4\n", "#EXT-X-MEDIA-SEQUENCE:0\n", "#EXTINF:4.000,\n0.ts?profile=hd\n", "#EXT-X-DISCONTINUITY\n#EXTINF:4.000,\n4.ts?profile=hd\n", "#EXTINF:1.000,\n5.ts?profile=hd\n#EXT-X-ENDLIST\n"} {
+		if !strings.Contains(playlist, line) {
+			t.Errorf("playlist does not contain %q:\n%s", line, playlist)
+		}
+	}
+
+	// Rolling window
+	s = NewSegmenter()
+	_, _ = s.Write(testStream(90000, 33))
+
+	if len(s.segments) != PlaylistSegments || s.segments[0].Sequence != 2 || s.discontinuity != 0 {
+		t.Errorf("unexpected playlist window: %d segments, first %d", len(s.segments), s.segments[0].Sequence)
+	}
+
+}
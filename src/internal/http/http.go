@@ -18,26 +18,9 @@ func DownloadFile(providerURL string, proxyUrl string) (filename string, body []
 		return
 	}
 
-	// Derive a timeout: prefer configured buffer timeout if provided, else default to 30s
-	requestTimeout := 30 * time.Second
-	if config.Settings.BufferTimeout > 0 {
-		requestTimeout = time.Duration(config.Settings.BufferTimeout*1000) * time.Millisecond
-	}
-
-	httpClient := &http.Client{Timeout: requestTimeout}
-
-	if proxyUrl != "" {
-		proxyURL, err := url.Parse(proxyUrl)
-		if err != nil {
-			return "", nil, err
-		}
-
-		httpClient = &http.Client{
-			Timeout: requestTimeout,
-			Transport: &http.Transport{
-				Proxy: http.ProxyURL(proxyURL),
-			},
-		}
+	httpClient, err := newClient(proxyUrl)
+	if err != nil {
+		return
 	}
 
 	req, err := http.NewRequest("GET", providerURL, nil)
@@ -87,3 +70,73 @@ func DownloadFile(providerURL string, proxyUrl string) (filename string, body []
 
 	return
 }
+
+// Get : Body of a GET request with additional request headers (e.g. cookies of a portal)
+func Get(providerURL string, proxyUrl string, header map[string]string) (body []byte, err error) {
+	httpClient, err := newClient(proxyUrl)
+	if err != nil {
+		return
+	}
+
+	req, err := http.NewRequest("GET", providerURL, nil)
+	if err != nil {
+		return
+	}
+
+	req.Header.Set("User-Agent", config.Settings.UserAgent)
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("%d: %s %s", resp.StatusCode, providerURL, http.StatusText(resp.StatusCode))
+		return
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// ProxyURL : HTTP proxy of the provider (http_proxy.ip, http_proxy.port), empty without proxy
+func ProxyURL(data map[string]interface{}) string {
+	ip, _ := data["http_proxy.ip"].(string)
+	port, _ := data["http_proxy.port"].(string)
+
+	if ip == "" || port == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("http://%s:%s", ip, port)
+}
+
+// newClient : HTTP client with the timeout of the buffer settings and the proxy of the provider
+func newClient(proxyUrl string) (httpClient *http.Client, err error) {
+	// Derive a timeout: prefer configured buffer timeout if provided, else default to 30s
+	requestTimeout := 30 * time.Second
+	if config.Settings.BufferTimeout > 0 {
+		requestTimeout = time.Duration(config.Settings.BufferTimeout*1000) * time.Millisecond
+	}
+
+	httpClient = &http.Client{Timeout: requestTimeout}
+
+	if proxyUrl != "" {
+		proxyURL, err := url.Parse(proxyUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		httpClient = &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				Proxy: http.ProxyURL(proxyURL),
+			},
+		}
+	}
+
+	return
+}
@@ -0,0 +1,148 @@
+package m3u
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// directiveRegex : Directives of a channel (#EXTVLCOPT:, #KODIPROP:, #EXTGRP:, ...), directives without a value are kept too
+var directiveRegex = regexp.MustCompile(`^#[A-Z][A-Z0-9-]*(:|$)`)
+
+// standardAttributes : Attributes of the #EXTINF line that are always written (Entry)
+var standardAttributes = []string{"tvg-id", "tvg-name", "tvg-chno", "tvg-logo", "group-title"}
+
+// isDirective : #EXTINF and the directives of the playlist (#EXTM3U, #PLAYLIST, #EXT-X-) do not belong to a channel
+func isDirective(line string) bool {
+
+	if !directiveRegex.MatchString(line) || line == "#EXTM3U" {
+		return false
+	}
+
+	return !strings.HasPrefix(line, "#EXTINF:") && !strings.HasPrefix(line, "#PLAYLIST:") && !strings.HasPrefix(line, "#EXT-X-")
+}
+
+// splitDirectives : Directives of a channel between the #EXTINF line and the URL, the directives after the URL belong to the next channel.
+// For the header of the playlist (#EXTM3U line) the directives before the first channel are returned.
+func splitDirectives(channel string) (directives, next []string) {
+
+	var lines = strings.Split(strings.ReplaceAll(channel, "\r\n", "\n"), "\n")
+	var url bool
+
+	for _, line := range lines[1:] {
+
+		line = strings.TrimSpace(line)
+
+		switch {
+
+		case isDirective(line):
+			if url {
+				next = append(next, line)
+			} else {
+				directives = append(directives, line)
+			}
+
+		case len(line) > 0 && !strings.HasPrefix(line, "#"):
+			url = true
+
+		}
+
+	}
+
+	return
+}
+
+// addAttribute : Name of an attribute of the #EXTINF line (_attributes), the order of the provider is kept
+func addAttribute(stream map[string]string, name string) {
+
+	var names = strings.Fields(stream["_attributes"])
+
+	for _, n := range names {
+		if n == name {
+			return
+		}
+	}
+
+	stream["_attributes"] = strings.Join(append(names, name), " ")
+}
+
+// addDirectives : Directives of the channel (_directives), one directive per line
+func addDirectives(stream map[string]string, directives []string) {
+
+	if len(stream) > 0 && len(directives) > 0 {
+		stream["_directives"] = strings.Join(directives, "\n")
+	}
+
+}
+
+// Attributes : Attributes of the #EXTINF line of the provider besides tvg-id, tvg-name, tvg-chno, tvg-logo and group-title (catchup, tvg-shift, tvg-rec, ...)
+func Attributes(stream map[string]string) (attributes map[string]string) {
+
+	for _, name := range strings.Fields(stream["_attributes"]) {
+
+		if isStandardAttribute(name) {
+			continue
+		}
+
+		if attributes == nil {
+			attributes = make(map[string]string)
+		}
+
+		attributes[name] = stream[name]
+
+	}
+
+	return
+}
+
+// Directives : Directives of the channel in the order of the provider (#EXTVLCOPT, #KODIPROP, #EXTGRP, ...)
+func Directives(stream map[string]string) []string {
+
+	if len(stream["_directives"]) == 0 {
+		return nil
+	}
+
+	return strings.Split(stream["_directives"], "\n")
+}
+
+// Entry : #EXTINF line, directives and URL of a channel, the attributes and directives of the provider are kept
+func Entry(stream map[string]string) string {
+
+	var quotes = strings.NewReplacer(`"`, "", "\n", " ", "\r", "")
+	var entry strings.Builder
+
+	entry.WriteString("#EXTINF:-1")
+
+	for _, name := range standardAttributes {
+		fmt.Fprintf(&entry, ` %s="%s"`, name, quotes.Replace(stream[name]))
+	}
+
+	for _, name := range strings.Fields(stream["_attributes"]) {
+
+		if !isStandardAttribute(name) {
+			fmt.Fprintf(&entry, ` %s="%s"`, name, quotes.Replace(stream[name]))
+		}
+
+	}
+
+	fmt.Fprintf(&entry, ",%s\n", stream["name"])
+
+	for _, directive := range Directives(stream) {
+		entry.WriteString(directive + "\n")
+	}
+
+	entry.WriteString(stream["url"] + "\n")
+
+	return entry.String()
+}
+
+func isStandardAttribute(name string) bool {
+
+	for _, standard := range standardAttributes {
+		if name == standard {
+			return true
+		}
+	}
+
+	return false
+}
@@ -82,3 +82,71 @@ func checkStream(streamInterface []interface{}) (err error) {
 
 	return
 }
+
+func TestAttributes(t *testing.T) {
+
+	// Header: #KODIPROP belongs to the first channel, #PLAYLIST and #EXT-X- are directives of the playlist and are skipped
+	var content = []byte(`#EXTM3U url-tvg="http://example.com/epg.xml"
+#PLAYLIST:Provider
+#EXT-X-SESSION-DATA:DATA-ID="com.example.title",VALUE="Provider"
+#KODIPROP:inputstream=inputstream.adaptive
+#EXTINF:-1 tvg-id="one.de" tvg-name="One" tvg-shift="2" catchup="default" catchup-days="7" catchup-source="http://example.com/1?utc={utc}" group-title="News",One
+#EXTVLCOPT:http-user-agent=Player/1.0
+#EXTGRP:News
+#EXTNOSEEK
+http://example.com/stream/1
+#KODIPROP:inputstream.adaptive.manifest_type=hls
+#EXTINF:-1 tvg-id="two.de" tvg-rec="3",Two
+http://example.com/stream/2
+`)
+
+	for name, parse := range map[string]func([]byte) ([]interface{}, error){"original": makeInterfaceFromM3UOriginal, "optimized": MakeInterfaceFromM3UOptimized} {
+
+		streams, err := parse(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(streams) != 2 {
+			t.Fatalf("%s: expected 2 streams, got %d", name, len(streams))
+		}
+
+		var one, two = streams[0].(map[string]string), streams[1].(map[string]string)
+
+		var attributes = Attributes(one)
+		if len(attributes) != 4 || attributes["tvg-shift"] != "2" || attributes["catchup"] != "default" || attributes["catchup-days"] != "7" || attributes["catchup-source"] != "http://example.com/1?utc={utc}" {
+			t.Errorf("%s: unexpected attributes %v", name, attributes)
+		}
+
+		var directives = Directives(one)
+		if len(directives) != 4 || directives[0] != "#KODIPROP:inputstream=inputstream.adaptive" || directives[1] != "#EXTVLCOPT:http-user-agent=Player/1.0" || directives[2] != "#EXTGRP:News" || directives[3] != "#EXTNOSEEK" {
+			t.Errorf("%s: unexpected directives %q", name, directives)
+		}
+
+		if attributes = Attributes(two); len(attributes) != 1 || attributes["tvg-rec"] != "3" {
+			t.Errorf("%s: unexpected attributes %v", name, attributes)
+		}
+
+		if directives = Directives(two); len(directives) != 1 || directives[0] != "#KODIPROP:inputstream.adaptive.manifest_type=hls" {
+			t.Errorf("%s: unexpected directives %q", name, directives)
+		}
+
+		// The provider file is written with Entry, the attributes and directives are kept
+		entries, err := parse([]byte("#EXTM3U\n" + Entry(one) + Entry(two)))
+		if err != nil || len(entries) != 2 {
+			t.Fatalf("%s: entries not parsed: %v", name, err)
+		}
+
+		for i, stream := range []map[string]string{one, two} {
+
+			var entry = entries[i].(map[string]string)
+
+			if fmt.Sprint(Attributes(entry)) != fmt.Sprint(Attributes(stream)) || entry["_directives"] != stream["_directives"] || entry["url"] != stream["url"] || entry["name"] != stream["name"] || entry["tvg-id"] != stream["tvg-id"] {
+				t.Errorf("%s: entry %d changed:\n%v\n%v", name, i, stream, entry)
+			}
+
+		}
+
+	}
+
+}
@@ -45,9 +45,13 @@ func makeInterfaceFromM3UOriginal(byteStream []byte) (allChannels []interface{},
 				if len(parameter) == 2 {
 					// Save TVG Key in lowercase
 					if strings.Contains(parameter[0], "tvg") {
-						stream[strings.ToLower(parameter[0])] = parameter[1]
-					} else {
-						stream[parameter[0]] = parameter[1]
+						parameter[0] = strings.ToLower(parameter[0])
+					}
+
+					stream[parameter[0]] = parameter[1]
+
+					if len(parameter[0]) > 0 {
+						addAttribute(stream, parameter[0])
 					}
 
 					// Do not pass URLs to the filter function
@@ -119,10 +123,18 @@ func makeInterfaceFromM3UOriginal(byteStream []byte) (allChannels []interface{},
 		content = strings.ReplaceAll(content, "'", "\"")
 		var channels = strings.Split(content, "#EXTINF")
 
+		// Directives before the first #EXTINF line belong to the first channel, the directives of the playlist (#PLAYLIST, #EXT-X-) are skipped
+		var pending, _ = splitDirectives(channels[0])
+
 		channels = append(channels[:0], channels[1:]...)
 
 		for _, channel := range channels {
 			var stream = parseMetaData(channel)
+			var directives, next = splitDirectives(channel)
+
+			addDirectives(stream, append(pending, directives...))
+			pending = next
+
 			if len(stream) > 0 && stream != nil {
 				allChannels = append(allChannels, stream)
 			}
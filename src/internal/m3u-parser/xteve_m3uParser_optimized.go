@@ -38,6 +38,7 @@ func MakeInterfaceFromM3UOptimized(byteStream []byte) (allChannels []interface{}
 	var currentChannel strings.Builder
 	var isInChannel bool
 	var lineCount int
+	var directives []string // Directives of the next channel (#EXTVLCOPT, #KODIPROP, ...), the directives before the first #EXTINF line belong to the first channel
 
 	// Pre-allocate channels slice with estimated capacity
 	estimatedChannels := bytes.Count(byteStream, []byte("#EXTINF"))
@@ -69,6 +70,9 @@ func MakeInterfaceFromM3UOptimized(byteStream []byte) (allChannels []interface{}
 
 		// Skip other # lines
 		if strings.HasPrefix(line, "#") {
+			if isDirective(strings.TrimSpace(line)) {
+				directives = append(directives, strings.TrimSpace(line))
+			}
 			continue
 		}
 
@@ -77,10 +81,12 @@ func MakeInterfaceFromM3UOptimized(byteStream []byte) (allChannels []interface{}
 			currentChannel.WriteString(line)
 			// Process complete channel
 			if stream := parseMetaDataOptimized(currentChannel.String()); len(stream) > 0 {
+				addDirectives(stream, directives)
 				allChannels = append(allChannels, stream)
 			}
 			currentChannel.Reset()
 			isInChannel = false
+			directives = nil
 		}
 	}
 
@@ -113,7 +119,8 @@ func parseMetaDataOptimized(channel string) map[string]string {
 		}
 	}
 
-	if len(lines) < 2 {
+	// The #EXTINF line starts with #, the URL is the only line without #
+	if len(lines) < 1 {
 		return nil // Invalid channel format
 	}
 
@@ -148,9 +155,13 @@ func parseMetaDataOptimized(channel string) map[string]string {
 
 			// Save TVG Key in lowercase
 			if strings.Contains(key, "tvg") {
-				stream[strings.ToLower(key)] = val
-			} else {
-				stream[key] = val
+				key = strings.ToLower(key)
+			}
+
+			stream[key] = val
+
+			if len(key) > 0 {
+				addAttribute(stream, key)
 			}
 
 			// Build value string (skip URLs)
@@ -1,6 +1,7 @@
 package m3u
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -8,58 +9,93 @@ import (
 	"os/exec"
 	"strings"
 	"threadfin/internal/config"
+	"threadfin/internal/stalker"
 	"threadfin/internal/structs"
 )
 
-func ProbeChannel(request structs.RequestStruct) (string, string, string, error) {
+// ProbeChannel : Stream metadata of the probe URL (ffprobe)
+func ProbeChannel(request structs.RequestStruct) (info structs.ProbeInfoStruct, err error) {
 
-	ffmpegPath := config.Settings.FFmpegPath
-	ffprobePath := strings.Replace(ffmpegPath, "ffmpeg", "ffprobe", 1)
-
-	cmd := exec.Command(ffprobePath, "-v", "error", "-show_streams", "-of", "json", request.ProbeURL)
-	output, err := cmd.Output()
+	probeURL, err := stalker.Resolve(request.ProbeURL)
 	if err != nil {
-		return "", "", "", fmt.Errorf("failed to execute ffprobe: %v", err)
+		return
 	}
 
-	var ffprobeOutput structs.FFProbeOutput
-	err = json.Unmarshal(output, &ffprobeOutput)
+	ffprobeOutput, err := ProbeURL(context.Background(), probeURL)
 	if err != nil {
-		return "", "", "", fmt.Errorf("failed to parse ffprobe output: %v", err)
+		return
 	}
 
-	var resolution, frameRate, audioChannels string
+	return ProbeInfo(ffprobeOutput), nil
+}
+
+// ProbeInfo : Resolution, frame rate, codecs and audio layout of the ffprobe streams
+func ProbeInfo(ffprobeOutput structs.FFProbeOutput) (info structs.ProbeInfoStruct) {
 
 	for _, stream := range ffprobeOutput.Streams {
 		if stream.CodecType == "video" {
-			resolution = fmt.Sprintf("%dp", stream.Height)
-			frameRateParts := strings.Split(stream.RFrameRate, "/")
-			if len(frameRateParts) == 2 {
-				frameRate = fmt.Sprintf("%d", parseFrameRate(frameRateParts))
-			} else {
-				frameRate = stream.RFrameRate
+			if stream.Height > 0 {
+				info.Resolution = fmt.Sprintf("%dp", stream.Height)
 			}
+			info.FrameRate = FrameRate(stream.RFrameRate)
+			info.VideoCodec = stream.CodecName
 		}
 		if stream.CodecType == "audio" {
-			audioChannels = stream.ChannelLayout
-			if audioChannels == "" {
+			info.AudioCodec = stream.CodecName
+			info.AudioChannel = stream.ChannelLayout
+			if info.AudioChannel == "" {
 				switch stream.Channels {
 				case 1:
-					audioChannels = "Mono"
+					info.AudioChannel = "Mono"
 				case 2:
-					audioChannels = "Stereo"
+					info.AudioChannel = "Stereo"
 				case 6:
-					audioChannels = "5.1"
+					info.AudioChannel = "5.1"
 				case 8:
-					audioChannels = "7.1"
+					info.AudioChannel = "7.1"
 				default:
-					audioChannels = fmt.Sprintf("%d channels", stream.Channels)
+					info.AudioChannel = fmt.Sprintf("%d channels", stream.Channels)
 				}
 			}
 		}
 	}
 
-	return resolution, frameRate, audioChannels, nil
+	return
+}
+
+// ProbeURL : Streams of the URL (ffprobe), the probe is canceled with the context. options: Input options of ffprobe (-user_agent, -headers, -http_proxy)
+func ProbeURL(ctx context.Context, url string, options ...string) (ffprobeOutput structs.FFProbeOutput, err error) {
+
+	var args = append([]string{"-v", "error", "-show_streams", "-of", "json"}, options...)
+
+	cmd := exec.CommandContext(ctx, FFProbePath(), append(args, url)...)
+	output, err := cmd.Output()
+	if err != nil {
+		return ffprobeOutput, fmt.Errorf("failed to execute ffprobe: %v", err)
+	}
+
+	err = json.Unmarshal(output, &ffprobeOutput)
+	if err != nil {
+		return ffprobeOutput, fmt.Errorf("failed to parse ffprobe output: %v", err)
+	}
+
+	return
+}
+
+// FFProbePath : ffprobe in the folder of ffmpeg (settings: ffmpeg.path)
+func FFProbePath() string {
+	return strings.Replace(config.Settings.FFmpegPath, "ffmpeg", "ffprobe", 1)
+}
+
+// FrameRate : Rounded frame rate of the ffprobe value (e.g. 50/1)
+func FrameRate(rFrameRate string) string {
+
+	frameRateParts := strings.Split(rFrameRate, "/")
+	if len(frameRateParts) == 2 {
+		return fmt.Sprintf("%d", parseFrameRate(frameRateParts))
+	}
+
+	return rFrameRate
 }
 
 func parseFrameRate(parts []string) int {
@@ -16,13 +16,16 @@ import (
 	jsonserializer "threadfin/internal/json-serializer"
 	"threadfin/internal/m3u-parser"
 	"threadfin/internal/provider"
+	"threadfin/internal/stalker"
 	"threadfin/internal/storage"
 	"threadfin/internal/stream"
 	"threadfin/internal/structs"
 	"threadfin/internal/utilities"
 )
 
-func Build(groups []string) (m3u string, err error) {
+// Build : Creates the M3U playlist. Without groups and buffer profile the playlist is saved as threadfin.m3u,
+// with a buffer profile (?profile=) the streaming URLs select this profile.
+func Build(groups []string, bufferProfile string) (m3u string, err error) {
 
 	var imgc = config.Data.Cache.Images
 	// Preserve every active channel as a distinct entry by iteration order, not keyed by number
@@ -97,7 +100,7 @@ func Build(groups []string) (m3u string, err error) {
 	// If generating the full file, stream to disk to avoid huge in-memory strings
 	var writer *bufio.Writer
 	var file *os.File
-	if len(groups) == 0 {
+	if len(groups) == 0 && len(bufferProfile) == 0 {
 		filename := config.System.Folder.Data + "threadfin.m3u"
 		file, err = os.Create(filename)
 		if err != nil {
@@ -145,6 +148,9 @@ func Build(groups []string) (m3u string, err error) {
 		return chI < chJ
 	})
 
+	// Attributes and directives of the providers
+	var filter = newAttributeFilter(config.Settings.M3UAttributesAllow, config.Settings.M3UAttributesDeny)
+
 	// Avoid duplicate exact stream URLs within the same group and cap per-group by expected minus deactivated
 	seenURLInGroup := make(map[string]struct{})
 	emittedGroupCount := make(map[string]int)
@@ -171,7 +177,7 @@ func Build(groups []string) (m3u string, err error) {
 		}
 
 		// Disabling so not to rewrite stream to https domain when disable stream from https set
-		if config.Settings.ForceHttps && config.Settings.HttpsThreadfinDomain != "" && !config.Settings.ExcludeStreamHttps {
+		if config.Settings.ForceHttps && config.Settings.HttpsThreadfinDomain != "" && !config.Settings.ExcludeStreamHttps && !stalker.IsStreamURL(channel.URL) {
 			u, err := url.Parse(channel.URL)
 			if err == nil {
 				u.Scheme = "https"
@@ -191,9 +197,13 @@ func Build(groups []string) (m3u string, err error) {
 		if channel.TvgLogo != "" {
 			logo = imgc.Image.GetURL(channel.TvgLogo, config.Settings.HttpThreadfinDomain, config.Settings.Port, config.Settings.ForceHttps, config.Settings.HttpsPort, config.Settings.HttpsThreadfinDomain)
 		}
-		var parameter = fmt.Sprintf(`#EXTINF:0 channelID="%s" tvg-chno="%s" tvg-name="%s" tvg-id="%s" tvg-logo="%s" group-title="%s",%s`+"\n", channel.XEPG, channel.XChannelID, channel.XName, channel.XChannelID, logo, group, channel.XName)
-		var stream, err = stream.CreateURL("M3U", channel.FileM3UID, channel.XChannelID, channel.XName, channel.URL, channel.BackupChannel1, channel.BackupChannel2, channel.BackupChannel3)
+		var parameter = fmt.Sprintf(`#EXTINF:0 channelID="%s" tvg-chno="%s" tvg-name="%s" tvg-id="%s" tvg-logo="%s" group-title="%s"%s%s,%s`+"\n", channel.XEPG, channel.XChannelID, channel.XName, channel.XChannelID, logo, group, videoAttributes(channel), filter.attributes(channel), channel.XName)
+		parameter += filter.directives(channel)
+		var stream, err = stream.CreateURL("M3U", channel.FileM3UID, channel.XChannelID, channel.XName, channel.URL, channel.BackupChannels, channel.XBufferProfile)
 		if err == nil {
+			if len(bufferProfile) > 0 {
+				stream += "?profile=" + url.QueryEscape(bufferProfile)
+			}
 			key := group + "|" + stream
 			if _, ok := seenURLInGroup[key]; ok {
 				continue
@@ -223,9 +233,115 @@ func Build(groups []string) (m3u string, err error) {
 	return
 }
 
+// videoAttributes : Stream metadata of the last probe (quality as in the XMLTV file, resolution and codecs)
+func videoAttributes(channel structs.XEPGChannelStruct) (attributes string) {
+
+	if quality := utilities.VideoQuality(channel.XResolution); len(quality) > 0 {
+		attributes += fmt.Sprintf(` video-quality="%s" video-resolution="%s"`, quality, channel.XResolution)
+	}
+
+	if len(channel.XVideoCodec) > 0 {
+		attributes += fmt.Sprintf(` video-codec="%s"`, channel.XVideoCodec)
+	}
+
+	if len(channel.XAudioCodec) > 0 {
+		attributes += fmt.Sprintf(` audio-codec="%s"`, channel.XAudioCodec)
+	}
+
+	return
+}
+
+// threadfinAttributes : Attributes of the #EXTINF line written by Threadfin, the attributes of the provider with the same name are not written
+var threadfinAttributes = []string{"channelID", "tvg-chno", "tvg-name", "tvg-id", "tvg-logo", "group-title", "video-quality", "video-resolution", "video-codec", "audio-codec"}
+
+// attributeFilter : Attributes and directives of the provider in the M3U file (settings: m3u.attributes.allow, m3u.attributes.deny).
+// The names of directives start with # (#EXTVLCOPT), * at the end of a name matches all names with this prefix (catchup*).
+type attributeFilter struct {
+	allow []string
+	deny  []string
+}
+
+func newAttributeFilter(allow, deny string) (filter attributeFilter) {
+
+	var names = func(list string) (names []string) {
+
+		for _, name := range strings.Split(list, ",") {
+
+			if name = strings.ToLower(strings.TrimSpace(name)); len(name) > 0 {
+				names = append(names, name)
+			}
+
+		}
+
+		return
+	}
+
+	filter.allow = names(allow)
+	filter.deny = names(deny)
+
+	return
+}
+
+// allowed : Attribute or directive in the allow list and not in the deny list
+func (filter attributeFilter) allowed(name string) bool {
+
+	var match = func(patterns []string) bool {
+
+		for _, pattern := range patterns {
+
+			if pattern == name || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(name, strings.TrimSuffix(pattern, "*"))) {
+				return true
+			}
+
+		}
+
+		return false
+	}
+
+	name = strings.ToLower(name)
+
+	return match(filter.allow) && !match(filter.deny)
+}
+
+// attributes : Attributes of the provider for the #EXTINF line, ordered by name
+func (filter attributeFilter) attributes(channel structs.XEPGChannelStruct) (attributes string) {
+
+	var names = make([]string, 0, len(channel.Attributes))
+
+	for name := range channel.Attributes {
+
+		if utilities.IndexOfString(name, threadfinAttributes) == -1 && filter.allowed(name) {
+			names = append(names, name)
+		}
+
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		attributes += fmt.Sprintf(` %s="%s"`, name, strings.ReplaceAll(channel.Attributes[name], `"`, ""))
+	}
+
+	return
+}
+
+// directives : Directives of the provider between the #EXTINF line and the stream URL, the name of a directive without a value is the whole directive
+func (filter attributeFilter) directives(channel structs.XEPGChannelStruct) (directives string) {
+
+	for _, directive := range channel.Directives {
+
+		if tag, _, _ := strings.Cut(directive, ":"); filter.allowed(tag) {
+			directives += directive + "\n"
+		}
+
+	}
+
+	return
+}
+
 func CreateFile() {
 	cli.ShowInfo("XEPG:" + fmt.Sprintf("Create M3U file (%s)", config.System.File.M3U))
-	_, err := Build([]string{})
+	_, err := Build([]string{}, "")
 	if err != nil {
 		cli.ShowError(err, 000)
 	}
@@ -0,0 +1,39 @@
+package m3u
+
+import (
+	"testing"
+	"threadfin/internal/structs"
+)
+
+func TestAttributeFilter(t *testing.T) {
+
+	var channel = structs.XEPGChannelStruct{
+		Attributes: map[string]string{"tvg-shift": "2", "catchup": "default", "catchup-days": "7", "tvg-rec": "3", "tvg-logo": "http://provider/logo.png", "x-note": `a "b"`},
+		Directives: []string{"#EXTVLCOPT:http-user-agent=Player/1.0", "#KODIPROP:inputstream=inputstream.adaptive", "#EXTGRP:News", "#EXT-X-DISCONTINUITY"},
+	}
+
+	var tests = []struct {
+		allow, deny, attributes, directives string
+	}{
+		{"*", "", ` catchup="default" catchup-days="7" tvg-rec="3" tvg-shift="2" x-note="a b"`, "#EXTVLCOPT:http-user-agent=Player/1.0\n#KODIPROP:inputstream=inputstream.adaptive\n#EXTGRP:News\n#EXT-X-DISCONTINUITY\n"},
+		{"", "", "", ""},
+		{"catchup*, #kodiprop", "", ` catchup="default" catchup-days="7"`, "#KODIPROP:inputstream=inputstream.adaptive\n"},
+		{"*", "tvg-rec,#EXTGRP, x-*, #ext-x-discontinuity", ` catchup="default" catchup-days="7" tvg-shift="2"`, "#EXTVLCOPT:http-user-agent=Player/1.0\n#KODIPROP:inputstream=inputstream.adaptive\n"},
+		{"tvg-shift,#*", "#EXTVLCOPT", ` tvg-shift="2"`, "#KODIPROP:inputstream=inputstream.adaptive\n#EXTGRP:News\n#EXT-X-DISCONTINUITY\n"},
+	}
+
+	for _, test := range tests {
+
+		var filter = newAttributeFilter(test.allow, test.deny)
+
+		if attributes := filter.attributes(channel); attributes != test.attributes {
+			t.Errorf("allow %q, deny %q: expected attributes %q, got %q", test.allow, test.deny, test.attributes, attributes)
+		}
+
+		if directives := filter.directives(channel); directives != test.directives {
+			t.Errorf("allow %q, deny %q: expected directives %q, got %q", test.allow, test.deny, test.directives, directives)
+		}
+
+	}
+
+}
@@ -0,0 +1,664 @@
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"threadfin/internal/buffer"
+	"threadfin/internal/channels"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	jsonserializer "threadfin/internal/json-serializer"
+	"threadfin/internal/m3u"
+	"threadfin/internal/provider"
+	"threadfin/internal/stalker"
+	"threadfin/internal/storage"
+	"threadfin/internal/structs"
+	"threadfin/internal/tuner"
+	"threadfin/internal/xmltv"
+	"time"
+)
+
+// Actions for dead channels (settings: probe.dead.action)
+const (
+	ActionNone       = "-"
+	ActionDeactivate = "deactivate" // The channel is deactivated in the mapping
+	ActionBackup     = "backup"     // Clients are sent to the first backup channel
+)
+
+// timeout : Max. time of a probe (first byte and ffprobe)
+const timeout = 20 * time.Second
+
+var (
+	mu      sync.Mutex
+	results = make(map[string]structs.ChannelProbe) // Key: XEPG ID
+)
+
+type job struct {
+	id      string
+	channel structs.XEPGChannelStruct
+}
+
+// Start : Loads the last results and starts the background probes of the active channels (settings: probe.interval)
+func Start() {
+
+	load()
+
+	go func() {
+
+		var last time.Time
+
+		for {
+
+			time.Sleep(time.Minute)
+
+			var interval = time.Duration(config.Settings.ProbeInterval) * time.Minute
+			if interval <= 0 || time.Since(last) < interval {
+				continue
+			}
+
+			// The XEPG channels are being created, the round starts when the XEPG channels are ready
+			if scanInProgress() {
+				continue
+			}
+
+			last = time.Now()
+			Round()
+
+		}
+
+	}()
+
+}
+
+// Round : Probes all active channels, the number of parallel probes is limited by probe.concurrency and the free tuners of the providers
+func Round() {
+
+	var jobs = activeChannels()
+	var queue = make(chan job)
+	var wg sync.WaitGroup
+	var dead, alive, probed []string
+	var roundMu sync.Mutex
+
+	cli.ShowInfo(fmt.Sprintf("Probe:Active channels: %d", len(jobs)))
+
+	for i := 0; i < max(config.Settings.ProbeConcurrency, 1); i++ {
+
+		wg.Add(1)
+
+		go func() {
+
+			defer wg.Done()
+
+			for j := range queue {
+
+				// The probe is canceled when a stream needs the tuner (tuner.StopProbes)
+				ctx, cancel := context.WithCancel(context.Background())
+
+				probeID, ok := wait(j.channel.FileM3UID, cancel)
+				if !ok {
+					cancel()
+					continue
+				}
+
+				result, ok := probeChannel(ctx, j.channel)
+				tuner.RemoveProbe(probeID)
+
+				if ctx.Err() != nil {
+					ok = false
+				}
+
+				cancel()
+
+				if !ok {
+					continue
+				}
+
+				roundMu.Lock()
+				if update(j.id, result) {
+					dead = append(dead, j.id)
+				}
+				if result.Reachable {
+					alive = append(alive, j.id)
+				}
+				if result.Reachable && len(result.VideoCodec+result.AudioCodec) > 0 {
+					probed = append(probed, j.id)
+				}
+				roundMu.Unlock()
+
+			}
+
+		}()
+
+	}
+
+	for _, j := range jobs {
+		queue <- j
+	}
+
+	close(queue)
+	wg.Wait()
+
+	prune()
+	save()
+
+	// The XEPG channels are also changed by the XEPG build and the mapping, the results are saved with the XEPG channels after the next round
+	config.XepgMutex.Lock()
+	defer config.XepgMutex.Unlock()
+
+	if config.System.ScanInProgress == 1 {
+		cli.ShowInfo("Probe:XEPG channels are being updated, the results are saved with the next round")
+		return
+	}
+
+	// Stream metadata of the channels (M3U, XMLTV and HDHomeRun lineup)
+	var changed = false
+	var probes = Results()
+
+	for _, id := range probed {
+
+		var result = probes[id]
+		var info = structs.ProbeInfoStruct{Resolution: result.Resolution, FrameRate: result.FrameRate, AudioChannel: result.AudioChannel, VideoCodec: result.VideoCodec, AudioCodec: result.AudioCodec}
+
+		if setMetadata(result.URL, info) {
+			changed = true
+		}
+
+	}
+
+	if len(dead) > 0 && config.Settings.ProbeDeadAction == ActionDeactivate && deactivate(dead) {
+		changed = true
+	}
+
+	// The channels that have been deactivated by the probes are activated again when the stream is available
+	if activate(alive) {
+		changed = true
+	}
+
+	// The primary channels of the channel groups can change with the new resolutions and the availability
+	if config.Settings.ChannelGroups && channels.Group(Availability()) {
+		changed = true
+	}
+
+	if changed {
+		if err := saveXEPG(); err != nil {
+			cli.ShowError(err, 0)
+		}
+	}
+
+}
+
+// SaveMetadata : Saves the stream metadata of a probe with the XEPG channels of the URL, the M3U and XMLTV files are created again
+func SaveMetadata(url string, info structs.ProbeInfoStruct) (err error) {
+
+	config.XepgMutex.Lock()
+	defer config.XepgMutex.Unlock()
+
+	// The XEPG channels are being updated, the metadata is saved with the next background probe
+	if config.System.ScanInProgress == 1 {
+		return
+	}
+
+	if !setMetadata(url, info) {
+		return
+	}
+
+	return saveXEPG()
+}
+
+// Results : Results of the last probes (web interface)
+func Results() (probes map[string]structs.ChannelProbe) {
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	probes = make(map[string]structs.ChannelProbe, len(results))
+	for id, result := range results {
+		probes[id] = result
+	}
+
+	return
+}
+
+// Availability : Successful probes of the channels in percent (key: XEPG ID), used for the primary channel of the channel groups
+func Availability() (availability map[string]float64) {
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	availability = make(map[string]float64, len(results))
+	for id, result := range results {
+
+		if result.Probes > 0 {
+			availability[id] = float64(result.Successes) * 100 / float64(result.Probes)
+		}
+
+	}
+
+	return
+}
+
+// Dead : The stream was flagged as dead by the last probes
+func Dead(playlistID, url string) bool {
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, result := range results {
+		if result.Dead && result.PlaylistID == playlistID && result.URL == url {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Backup : Stream for the clients of a dead channel (probe.dead.action: backup), the first backup channel that is not dead itself
+func Backup(streamInfo structs.StreamInfo) (structs.StreamInfo, bool) {
+
+	if config.Settings.ProbeDeadAction != ActionBackup || !Dead(streamInfo.PlaylistID, streamInfo.URL) {
+		return streamInfo, false
+	}
+
+	for i, backup := range streamInfo.BackupChannels {
+
+		if Dead(backup.PlaylistID, backup.URL) {
+			continue
+		}
+
+		cli.ShowInfo(fmt.Sprintf("Probe:Channel: %s - Dead stream, backup channel %d is used", streamInfo.Name, i+1))
+
+		streamInfo.PlaylistID, streamInfo.URL = backup.PlaylistID, backup.URL
+		streamInfo.BackupChannels = streamInfo.BackupChannels[i+1:]
+
+		return streamInfo, true
+	}
+
+	return streamInfo, false
+}
+
+// scanInProgress : The XEPG channels are being created, the XEPG builds set ScanInProgress with config.XepgMutex
+func scanInProgress() bool {
+
+	config.XepgMutex.Lock()
+	defer config.XepgMutex.Unlock()
+
+	return config.System.ScanInProgress == 1
+}
+
+// activeChannels : Snapshot of the active XEPG channels and the channels deactivated by the probes, sorted by ID.
+// No channels while the XEPG channels are being created.
+func activeChannels() (jobs []job) {
+
+	config.XepgMutex.Lock()
+	defer config.XepgMutex.Unlock()
+
+	if config.System.ScanInProgress == 1 {
+		return
+	}
+
+	for id, dxc := range config.Data.XEPG.Channels {
+
+		var channel structs.XEPGChannelStruct
+		if err := json.Unmarshal([]byte(jsonserializer.MapToJSON(dxc)), &channel); err != nil || !(channel.XActive || channel.XProbeDeactivated) || len(channel.URL) == 0 {
+			continue
+		}
+
+		jobs = append(jobs, job{id: id, channel: channel})
+
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].id < jobs[j].id
+	})
+
+	return
+}
+
+// wait : Reserves a tuner of the provider for the probe (tuner.AddProbe) and waits while the tuners are used by other probes.
+// If the tuners are used by streams, the channel is probed in the next round.
+func wait(playlistID string, cancel context.CancelFunc) (probeID int, ok bool) {
+
+	var playlistType = playlistTypeOf(playlistID)
+
+	for {
+
+		if probeID, ok = tuner.AddProbe(playlistID, playlistType, cancel); ok {
+			return
+		}
+
+		if tuner.Probes(playlistID) == 0 && tuner.PoolProbes(tuner.GetPool(playlistID, playlistType)) == 0 {
+			return
+		}
+
+		time.Sleep(time.Second)
+
+	}
+
+}
+
+// update : Saves the result of a probe, returns true if the channel has just been flagged as dead
+func update(id string, result structs.ChannelProbe) (dead bool) {
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	result.Probes = results[id].Probes + 1
+	result.Successes = results[id].Successes
+
+	if result.Reachable {
+		result.Successes++
+	}
+
+	if !result.Reachable {
+
+		result.Failures = results[id].Failures + 1
+		result.Dead = result.Failures >= max(config.Settings.ProbeFailures, 1)
+		dead = result.Dead && !results[id].Dead
+
+		if dead {
+			cli.ShowInfo(fmt.Sprintf("Probe:Channel: %s - Dead stream (%s)", result.Name, result.Error))
+		}
+
+	}
+
+	results[id] = result
+
+	return
+}
+
+// probeChannel : Time to the first byte of the stream (HTTP) and the streams of the channel (ffprobe).
+// Without ffprobe only HTTP streams can be probed, ok is false for the other streams.
+func probeChannel(ctx context.Context, channel structs.XEPGChannelStruct) (result structs.ChannelProbe, ok bool) {
+
+	result = structs.ChannelProbe{Name: channel.XName, PlaylistID: channel.FileM3UID, URL: channel.URL, Time: time.Now()}
+
+	// Stalker portals: the link of the stream is created for the probe, the result is saved with the URL of the playlist
+	streamURL, err := stalker.Resolve(channel.URL)
+	if err != nil {
+		result.Error = err.Error()
+		return result, true
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var playlist = playlistOf(channel.FileM3UID)
+	var isHTTP = strings.HasPrefix(streamURL, "http://") || strings.HasPrefix(streamURL, "https://")
+
+	if isHTTP {
+
+		ttfb, err := firstByte(ctx, playlist, streamURL)
+		if err != nil {
+			result.Error = err.Error()
+			return result, true
+		}
+
+		result.TTFB = ttfb.Milliseconds()
+
+	}
+
+	if _, err := exec.LookPath(m3u.FFProbePath()); err != nil {
+		result.Reachable = isHTTP
+		return result, isHTTP
+	}
+
+	output, err := m3u.ProbeURL(ctx, streamURL, ffprobeOptions(playlist)...)
+	if err != nil {
+		result.Error = err.Error()
+		return result, true
+	}
+
+	if len(output.Streams) == 0 {
+		result.Error = "no streams"
+		return result, true
+	}
+
+	var info = m3u.ProbeInfo(output)
+
+	result.Reachable = true
+	result.Resolution, result.FrameRate = info.Resolution, info.FrameRate
+	result.VideoCodec, result.AudioCodec, result.AudioChannel = info.VideoCodec, info.AudioCodec, info.AudioChannel
+
+	return result, true
+}
+
+// firstByte : Time until the streaming server sends the first byte of the stream.
+// The request is sent like the requests of the Threadfin buffer (HTTP proxy, User-Agent, Referer and Origin of the playlist).
+func firstByte(ctx context.Context, playlist structs.Playlist, url string) (ttfb time.Duration, err error) {
+
+	var start = time.Now()
+
+	resp, err := buffer.GetStream(ctx, playlist, url)
+	if err != nil {
+		return
+	}
+
+	defer resp.Body.Close()
+
+	if _, err = io.ReadFull(resp.Body, make([]byte, 1)); err != nil {
+		return 0, errors.New("no data")
+	}
+
+	return time.Since(start), nil
+}
+
+// ffprobeOptions : HTTP proxy, User-Agent, Referer and Origin of the playlist for ffprobe
+func ffprobeOptions(playlist structs.Playlist) (options []string) {
+
+	if len(config.Settings.UserAgent) > 0 {
+		options = append(options, "-user_agent", config.Settings.UserAgent)
+	}
+
+	var headers string
+
+	if len(playlist.HttpUserReferer) > 0 {
+		headers += "Referer: " + playlist.HttpUserReferer + "\r\n"
+	}
+
+	if len(playlist.HttpUserOrigin) > 0 {
+		headers += "Origin: " + playlist.HttpUserOrigin + "\r\n"
+	}
+
+	if len(headers) > 0 {
+		options = append(options, "-headers", headers)
+	}
+
+	if len(playlist.HttpProxyIP) > 0 && len(playlist.HttpProxyPort) > 0 {
+		options = append(options, "-http_proxy", fmt.Sprintf("http://%s:%s", playlist.HttpProxyIP, playlist.HttpProxyPort))
+	}
+
+	return
+}
+
+// deactivate : Deactivates the dead channels in the mapping (probe.dead.action: deactivate), returns true if a channel has changed.
+// The channels are marked (x-probe-deactivated) and are still probed. config.XepgMutex is locked by the caller.
+func deactivate(ids []string) (changed bool) {
+
+	for _, id := range ids {
+
+		dxc, ok := config.Data.XEPG.Channels[id]
+		if !ok {
+			continue
+		}
+
+		var channel structs.XEPGChannelStruct
+		if err := json.Unmarshal([]byte(jsonserializer.MapToJSON(dxc)), &channel); err != nil {
+			cli.ShowError(err, 0)
+			continue
+		}
+
+		if !channel.XActive {
+			continue
+		}
+
+		channel.XActive, channel.XProbeDeactivated = false, true
+		config.Data.XEPG.Channels[id] = channel
+		changed = true
+
+		cli.ShowInfo(fmt.Sprintf("Probe:Channel: %s - Deactivated", channel.XName))
+
+	}
+
+	if changed {
+		config.Data.Cache.StreamingURLS = make(map[string]structs.StreamInfo)
+	}
+
+	return
+}
+
+// activate : Activates the channels that have been deactivated by the probes (x-probe-deactivated), returns true if a channel has changed.
+// Channels that have been deactivated by the user are not changed. config.XepgMutex is locked by the caller.
+func activate(ids []string) (changed bool) {
+
+	for _, id := range ids {
+
+		dxc, ok := config.Data.XEPG.Channels[id]
+		if !ok {
+			continue
+		}
+
+		var channel structs.XEPGChannelStruct
+		if err := json.Unmarshal([]byte(jsonserializer.MapToJSON(dxc)), &channel); err != nil || !channel.XProbeDeactivated {
+			continue
+		}
+
+		channel.XActive, channel.XProbeDeactivated = true, false
+		config.Data.XEPG.Channels[id] = channel
+		changed = true
+
+		cli.ShowInfo(fmt.Sprintf("Probe:Channel: %s - Activated again", channel.XName))
+
+	}
+
+	if changed {
+		config.Data.Cache.StreamingURLS = make(map[string]structs.StreamInfo)
+	}
+
+	return
+}
+
+// setMetadata : Stream metadata of the XEPG channels with the URL, returns true if a channel has changed. config.XepgMutex is locked by the caller.
+func setMetadata(url string, info structs.ProbeInfoStruct) (changed bool) {
+
+	for id, dxc := range config.Data.XEPG.Channels {
+
+		var channel structs.XEPGChannelStruct
+		if err := json.Unmarshal([]byte(jsonserializer.MapToJSON(dxc)), &channel); err != nil || channel.URL != url {
+			continue
+		}
+
+		var metadata = structs.ProbeInfoStruct{Resolution: channel.XResolution, FrameRate: channel.XFrameRate, AudioChannel: channel.XAudioChannel, VideoCodec: channel.XVideoCodec, AudioCodec: channel.XAudioCodec}
+		if metadata == info {
+			continue
+		}
+
+		channel.XResolution, channel.XFrameRate, channel.XAudioChannel = info.Resolution, info.FrameRate, info.AudioChannel
+		channel.XVideoCodec, channel.XAudioCodec = info.VideoCodec, info.AudioCodec
+		config.Data.XEPG.Channels[id] = channel
+
+		changed = true
+
+	}
+
+	return
+}
+
+// saveXEPG : Saves the XEPG channels, the M3U and XMLTV files are created again.
+// config.XepgMutex is locked by the caller and no XEPG build is running (ScanInProgress).
+func saveXEPG() (err error) {
+
+	if err = storage.SaveMapToJSONFile(config.System.File.XEPG, config.Data.XEPG.Channels); err != nil {
+		return
+	}
+
+	if err = xmltv.CreateFile(); err != nil {
+		cli.ShowError(err, 0)
+	}
+
+	m3u.CreateFile()
+
+	return nil
+}
+
+// prune : Removes the results of channels that have been removed from the mapping, not while the XEPG channels are being created
+func prune() {
+
+	config.XepgMutex.Lock()
+	defer config.XepgMutex.Unlock()
+
+	if config.System.ScanInProgress == 1 {
+		return
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for id := range results {
+		if _, ok := config.Data.XEPG.Channels[id]; !ok {
+			delete(results, id)
+		}
+	}
+
+}
+
+// playlistOf : HTTP settings of the playlist like in the buffer of a stream
+func playlistOf(playlistID string) (playlist structs.Playlist) {
+
+	var playlistType = playlistTypeOf(playlistID)
+
+	playlist.PlaylistID = playlistID
+	playlist.HttpProxyIP = provider.GetProviderParameter(playlistID, playlistType, "http_proxy.ip")
+	playlist.HttpProxyPort = provider.GetProviderParameter(playlistID, playlistType, "http_proxy.port")
+	playlist.HttpUserOrigin = provider.GetProviderParameter(playlistID, playlistType, "http_headers.origin")
+	playlist.HttpUserReferer = provider.GetProviderParameter(playlistID, playlistType, "http_headers.referer")
+
+	return
+}
+
+func playlistTypeOf(playlistID string) string {
+
+	if strings.HasPrefix(playlistID, "H") {
+		return "hdhr"
+	}
+
+	return "m3u"
+}
+
+func file() string {
+	return config.System.Folder.Config + "probe.json"
+}
+
+func load() {
+
+	data, err := os.ReadFile(file())
+	if err != nil {
+		return
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err = json.Unmarshal(data, &results); err != nil {
+		cli.ShowError(err, 0)
+	}
+
+}
+
+func save() {
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := storage.SaveMapToJSONFile(file(), results); err != nil {
+		cli.ShowError(err, 0)
+	}
+
+}
@@ -0,0 +1,155 @@
+package probe
+
+import (
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+)
+
+func TestUpdate(t *testing.T) {
+
+	config.Settings.ProbeFailures = 2
+	defer func() {
+		config.Settings.ProbeFailures = 0
+		delete(results, "1")
+	}()
+
+	var failed = structs.ChannelProbe{Name: "Channel", Error: "HTTP status 404"}
+
+	if update("1", failed) || results["1"].Dead {
+		t.Error("dead after 1 failed probe")
+	}
+
+	if !update("1", failed) || !results["1"].Dead {
+		t.Error("not dead after 2 failed probes")
+	}
+
+	// The channel is only reported once
+	if update("1", failed) || results["1"].Failures != 3 {
+		t.Errorf("unexpected result: %+v", results["1"])
+	}
+
+	update("1", structs.ChannelProbe{Name: "Channel", Reachable: true})
+
+	if results["1"].Dead || results["1"].Failures != 0 {
+		t.Errorf("unexpected result after a successful probe: %+v", results["1"])
+	}
+
+	if availability := Availability()["1"]; availability != 25 {
+		t.Errorf("availability: expected 25, got %v", availability)
+	}
+
+}
+
+func TestSetMetadata(t *testing.T) {
+
+	config.Data.XEPG.Channels = map[string]any{
+		"x-ID.1": map[string]any{"x-name": "Channel 1", "x-active": true, "url": "http://provider/1.ts"},
+		"x-ID.2": structs.XEPGChannelStruct{XName: "Channel 1 (copy)", URL: "http://provider/1.ts"},
+		"x-ID.3": map[string]any{"x-name": "Channel 3", "url": "http://provider/3.ts"},
+	}
+	defer func() {
+		config.Data.XEPG.Channels = nil
+	}()
+
+	var info = structs.ProbeInfoStruct{Resolution: "1080p", FrameRate: "50", AudioChannel: "Stereo", VideoCodec: "h264", AudioCodec: "aac"}
+
+	if !setMetadata("http://provider/1.ts", info) {
+		t.Fatal("metadata not changed")
+	}
+
+	for _, id := range []string{"x-ID.1", "x-ID.2"} {
+		if channel, ok := config.Data.XEPG.Channels[id].(structs.XEPGChannelStruct); !ok || channel.XResolution != "1080p" || channel.XVideoCodec != "h264" || channel.XAudioCodec != "aac" || len(channel.XName) == 0 {
+			t.Errorf("%s: unexpected channel: %+v", id, config.Data.XEPG.Channels[id])
+		}
+	}
+
+	if _, ok := config.Data.XEPG.Channels["x-ID.3"].(map[string]any); !ok {
+		t.Error("x-ID.3: channel of another stream changed")
+	}
+
+	// Same metadata: the files are not created again
+	if setMetadata("http://provider/1.ts", info) {
+		t.Error("unchanged metadata")
+	}
+
+}
+
+func TestScanInProgress(t *testing.T) {
+
+	config.Data.XEPG.Channels = map[string]any{"x-ID.1": structs.XEPGChannelStruct{XName: "Channel 1", XActive: true, URL: "http://provider/1.ts"}}
+	results["x-ID.2"] = structs.ChannelProbe{Name: "Channel 2"}
+	config.System.ScanInProgress = 1
+	defer func() {
+		config.Data.XEPG.Channels = nil
+		config.System.ScanInProgress = 0
+		delete(results, "x-ID.2")
+	}()
+
+	// The XEPG channels are being created: no probes, the results of the channels are kept
+	if jobs := activeChannels(); len(jobs) != 0 || !scanInProgress() {
+		t.Errorf("channels probed during the XEPG build: %v", jobs)
+	}
+
+	prune()
+
+	if _, ok := results["x-ID.2"]; !ok {
+		t.Error("results removed during the XEPG build")
+	}
+
+	config.System.ScanInProgress = 0
+
+	if jobs := activeChannels(); len(jobs) != 1 || scanInProgress() {
+		t.Errorf("unexpected channels: %v", jobs)
+	}
+
+	prune()
+
+	if _, ok := results["x-ID.2"]; ok {
+		t.Error("result of a removed channel kept")
+	}
+
+}
+
+func TestDeactivate(t *testing.T) {
+
+	config.Data.XEPG.Channels = map[string]any{
+		"x-ID.1": map[string]any{"x-name": "Channel 1", "x-active": true, "url": "http://provider/1.ts"},
+		"x-ID.2": map[string]any{"x-name": "Channel 2", "x-active": false, "url": "http://provider/2.ts"},
+	}
+	defer func() {
+		config.Data.XEPG.Channels = nil
+	}()
+
+	var channel = func(id string) structs.XEPGChannelStruct {
+		c, _ := config.Data.XEPG.Channels[id].(structs.XEPGChannelStruct)
+		return c
+	}
+
+	if !deactivate([]string{"x-ID.1", "x-ID.2"}) || channel("x-ID.1").XActive || !channel("x-ID.1").XProbeDeactivated {
+		t.Fatalf("dead channel not deactivated: %+v", config.Data.XEPG.Channels["x-ID.1"])
+	}
+
+	if _, ok := config.Data.XEPG.Channels["x-ID.2"].(map[string]any); !ok {
+		t.Error("channel of the user changed")
+	}
+
+	// The deactivated channel is still probed
+	if jobs := activeChannels(); len(jobs) != 1 || jobs[0].id != "x-ID.1" {
+		t.Errorf("unexpected channels: %v", jobs)
+	}
+
+	// Successful probes: only the channel deactivated by the probes is activated again
+	if !activate([]string{"x-ID.1", "x-ID.2"}) || !channel("x-ID.1").XActive || channel("x-ID.1").XProbeDeactivated {
+		t.Errorf("channel not activated again: %+v", config.Data.XEPG.Channels["x-ID.1"])
+	}
+
+	if _, ok := config.Data.XEPG.Channels["x-ID.2"].(map[string]any); !ok {
+		t.Error("channel deactivated by the user has been activated")
+	}
+
+	if activate([]string{"x-ID.1"}) {
+		t.Error("active channel changed")
+	}
+
+}
@@ -2,18 +2,20 @@ package provider
 
 import (
 	"encoding/xml"
-	"fmt"
 	"strconv"
 	"strings"
 	"threadfin/internal/cli"
 	"threadfin/internal/compression"
 	"threadfin/internal/config"
+	"threadfin/internal/enigma2"
 	"threadfin/internal/http"
 	jsonserializer "threadfin/internal/json-serializer"
 	"threadfin/internal/m3u-parser"
 	"threadfin/internal/settings"
+	"threadfin/internal/stalker"
 	"threadfin/internal/storage"
 	"threadfin/internal/structs"
+	"threadfin/internal/xtream"
 	"time"
 )
 
@@ -143,22 +145,12 @@ func GetData(fileType, fileID string) (err error) {
 				return err
 			}
 
+			// The attributes and directives of the provider (catchup, tvg-shift, #EXTVLCOPT, #KODIPROP, ...) are kept
 			var m3uContent strings.Builder
 			m3uContent.WriteString("#EXTM3U\n")
 
 			for _, channel := range newM3u {
-				channelMap := channel.(map[string]string)
-
-				extinf := fmt.Sprintf(`#EXTINF:-1 tvg-id="%s" tvg-name="%s" tvg-chno="%s" tvg-logo="%s" group-title="%s",%s`,
-					channelMap["tvg-id"],
-					channelMap["tvg-name"],
-					channelMap["tvg-chno"],
-					channelMap["tvg-logo"],
-					channelMap["group-title"],
-					channelMap["name"],
-				)
-
-				m3uContent.WriteString(extinf + "\n" + channelMap["url"] + "\n")
+				m3uContent.WriteString(m3u.Entry(channel.(map[string]string)))
 			}
 
 			m3uBytes := []byte(m3uContent.String())
@@ -209,18 +201,7 @@ func GetData(fileType, fileID string) (err error) {
 
 		var data = d.(map[string]interface{})
 		var fileSource = data["file.source"].(string)
-		var httpProxyIp = ""
-		if data["http_proxy.ip"] != nil {
-			httpProxyIp = data["http_proxy.ip"].(string)
-		}
-		var httpProxyPort = ""
-		if data["http_proxy.port"] != nil {
-			httpProxyPort = data["http_proxy.port"].(string)
-		}
-		var httpProxyUrl = ""
-		if httpProxyIp != "" && httpProxyPort != "" {
-			httpProxyUrl = fmt.Sprintf("http://%s:%s", httpProxyIp, httpProxyPort)
-		}
+		var httpProxyUrl = http.ProxyURL(data)
 
 		var newProvider = false
 
@@ -247,7 +228,26 @@ func GetData(fileType, fileID string) (err error) {
 
 		default:
 
-			if strings.Contains(fileSource, "http://") || strings.Contains(fileSource, "https://") {
+			if data["source.type"] == xtream.SourceType {
+
+				// Laden vom Xtream Codes Panel (player_api.php, xmltv.php)
+				cli.ShowInfo("Xtream:" + fileSource)
+				serverFileName, body, err = xtream.Download(fileType, xtream.NewClient(data, httpProxyUrl))
+
+			} else if data["source.type"] == stalker.SourceType {
+
+				// Laden vom Stalker Portal (Genres und Kanäle), die Stream URLs werden beim Abspielen erstellt
+				cli.ShowInfo("Stalker:" + fileSource)
+				serverFileName, body, err = stalker.Download(dataID, stalker.NewClient(data, httpProxyUrl))
+
+			} else if data["source.type"] == enigma2.SourceType {
+
+				// Laden vom Enigma2 Receiver (OpenWebif: Bouquets, Services und EPG)
+				var client = enigma2.NewClient(data, httpProxyUrl)
+				cli.ShowInfo("Enigma2:" + client.URL)
+				serverFileName, body, err = enigma2.Download(fileType, client)
+
+			} else if strings.Contains(fileSource, "http://") || strings.Contains(fileSource, "https://") {
 
 				// Laden vom Remote Server
 				cli.ShowInfo("Download:" + fileSource)
@@ -373,6 +373,36 @@ func GetProviderParameter(id, fileType, key string) (s string) {
 	return
 }
 
+// GetAccounts : Credentials of the provider (key: accounts), "Username:Password:Tuner" separated by "|". Token based URLs use an empty username.
+func GetAccounts(id, fileType string) (accounts []structs.ProviderAccount) {
+
+	for _, entry := range strings.Split(GetProviderParameter(id, fileType, "accounts"), "|") {
+
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+
+		var account = structs.ProviderAccount{Tuner: 1}
+
+		username, password, _ := strings.Cut(entry, ":")
+		account.Username = username
+		account.Password = password
+
+		// The password can contain ":", the tuner is the last value
+		if i := strings.LastIndex(password, ":"); i != -1 {
+			if tuner, err := strconv.Atoi(password[i+1:]); err == nil && tuner > 0 {
+				account.Password = password[:i]
+				account.Tuner = tuner
+			}
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return
+}
+
 // Provider Statistiken Kompatibilität aktualisieren
 func SetCompatibility(id, fileType string, compatibility map[string]int) {
 	var dataMap = make(map[string]interface{})
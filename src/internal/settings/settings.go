@@ -68,12 +68,19 @@ func Load() (settings structs.SettingsStruct, err error) {
 	defaults["authentication.pms"] = false
 	defaults["authentication.web"] = false
 	defaults["authentication.xml"] = false
+	defaults["authentication.xtream"] = false
 	defaults["backup.keep"] = 10
 	defaults["backup.path"] = config.System.Folder.Backup
 	defaults["buffer"] = "-"
+	defaults["buffer.profiles"] = make(map[string]interface{})
 	defaults["buffer.size.kb"] = 1024
+	defaults["buffer.stall.timeout"] = 20
+	defaults["buffer.stream.max.mb"] = 64
 	defaults["buffer.timeout"] = 500
 	defaults["cache.images"] = false
+	defaults["channel.groups"] = false
+	defaults["channel.groups.rules"] = "resolution,priority,availability"
+	defaults["command.options"] = config.System.Command.DefaultOptions
 	defaults["epgSource"] = "PMS"
 	defaults["ffmpeg.options"] = config.System.FFmpeg.DefaultOptions
 	defaults["vlc.options"] = config.System.VLC.DefaultOptions
@@ -86,10 +93,17 @@ func Load() (settings structs.SettingsStruct, err error) {
 	defaults["mapping.first.channel"] = 1000
 	defaults["xepg.replace.missing.images"] = true
 	defaults["xepg.replace.channel.title"] = false
+	defaults["m3u.attributes.allow"] = "*"
+	defaults["m3u.attributes.deny"] = ""
 	defaults["m3u8.adaptive.bandwidth.mbps"] = 10
 	defaults["port"] = "34400"
+	defaults["probe.concurrency"] = 2
+	defaults["probe.dead.action"] = "-"
+	defaults["probe.failures"] = 3
+	defaults["probe.interval"] = 0
 	defaults["ssdp"] = true
 	defaults["storeBufferInRAM"] = true
+	defaults["stream.priorities"] = make([]interface{}, 0)
 	defaults["forceHttps"] = false
 	defaults["excludeStreamHttps"] = false
 	defaults["httpsPort"] = 443
@@ -99,10 +113,12 @@ func Load() (settings structs.SettingsStruct, err error) {
 	defaults["epgCategories"] = "Kids:kids|News:news|Movie:movie|Series:series|Sports:sports"
 	defaults["epgCategoriesColors"] = "kids:mediumpurple|news:tomato|movie:royalblue|series:gold|sports:yellowgreen"
 	defaults["tuner"] = 1
+	defaults["tuner.pools"] = make(map[string]interface{})
 	defaults["update"] = []string{"0000"}
 	defaults["user.agent"] = config.System.Name
 	defaults["uuid"] = utilities.CreateUUID()
 	defaults["udpxy"] = ""
+	defaults["udp.interface"] = ""
 	defaults["version"] = config.System.DBVersion
 	defaults["ThreadfinAutoUpdate"] = true
 	if isRunningInContainer() {
@@ -139,8 +155,9 @@ func Load() (settings structs.SettingsStruct, err error) {
 		settings.VLCPath = storage.SearchFileInOS("cvlc")
 	}
 
-	// Initialze virutal filesystem for the Buffer
-	buffer.InitVFS()
+	if len(settings.CommandPath) == 0 {
+		settings.CommandPath = storage.SearchFileInOS("streamlink")
+	}
 
 	settings.Version = config.System.DBVersion
 
@@ -149,6 +166,9 @@ func Load() (settings structs.SettingsStruct, err error) {
 		return structs.SettingsStruct{}, err
 	}
 
+	// Initialze virutal filesystem for the Buffer (after saving, the temporary folder and StoreBufferInRAM are set)
+	buffer.InitVFS()
+
 	// Warung wenn FFmpeg nicht gefunden wurde
 	if len(config.Settings.FFmpegPath) == 0 && config.Settings.Buffer == "ffmpeg" {
 		cli.ShowWarning(2020)
@@ -158,6 +178,10 @@ func Load() (settings structs.SettingsStruct, err error) {
 		cli.ShowWarning(2021)
 	}
 
+	if len(config.Settings.CommandPath) == 0 && config.Settings.Buffer == "command" {
+		cli.ShowWarning(2022)
+	}
+
 	return settings, nil
 }
 
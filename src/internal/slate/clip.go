@@ -0,0 +1,215 @@
+package slate
+
+import (
+	"errors"
+	"threadfin/internal/cli"
+	"time"
+)
+
+const (
+	tsPacketSize = 188
+	clockRate    = 90000   // PTS / DTS / PCR base
+	wrap         = 1 << 33 // 33 bit timestamps
+)
+
+// clip : MPEG-TS packets of a slate. Each repetition continues the PCR, PTS / DTS and the continuity counters of the previous one.
+type clip struct {
+	data     []byte
+	duration int64 // 90 kHz
+	loop     int64
+	counters map[uint16]byte
+}
+
+func newClip(data []byte) (c *clip, err error) {
+
+	data = data[:len(data)-len(data)%tsPacketSize]
+	if len(data) == 0 {
+		return nil, errors.New(cli.GetErrMsg(4013))
+	}
+
+	for i := 0; i < len(data); i += tsPacketSize {
+		if data[i] != 0x47 {
+			return nil, errors.New(cli.GetErrMsg(4013))
+		}
+	}
+
+	c = &clip{data: data, counters: make(map[uint16]byte)}
+	c.duration = clipDuration(data)
+
+	return
+}
+
+// hasPAT : The clip contains a program association table (PID 0 with payload unit start indicator)
+func hasPAT(data []byte) bool {
+
+	for i := 0; i+tsPacketSize <= len(data); i += tsPacketSize {
+		if data[i] == 0x47 && data[i+1]&0x40 != 0 && data[i+1]&0x1f == 0 && data[i+2] == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// next : Next repetition of the clip
+func (c *clip) next() (data []byte) {
+
+	data = make([]byte, len(c.data))
+	copy(data, c.data)
+
+	var offset = c.loop * c.duration % wrap
+
+	for i := 0; i < len(data); i += tsPacketSize {
+
+		var p = data[i : i+tsPacketSize]
+		var pid = uint16(p[1]&0x1f)<<8 | uint16(p[2])
+
+		if pid == 0x1fff {
+			continue
+		}
+
+		// Continuity counter (packets with payload)
+		if p[3]&0x10 != 0 {
+			p[3] = p[3]&0xf0 | c.counters[pid]
+			c.counters[pid] = (c.counters[pid] + 1) & 0x0f
+		}
+
+		if pcr := pcrField(p); pcr != nil {
+			writePCR(pcr, (readPCR(pcr)+offset)%wrap)
+		}
+
+		if pts, dts := timestampFields(p); pts != nil {
+			writeTimestamp(pts, (readTimestamp(pts)+offset)%wrap)
+			if dts != nil {
+				writeTimestamp(dts, (readTimestamp(dts)+offset)%wrap)
+			}
+		}
+
+	}
+
+	c.loop++
+
+	return
+}
+
+// length : Playback time of one repetition
+func (c *clip) length() time.Duration {
+	return time.Duration(c.duration) * time.Second / clockRate
+}
+
+// played : Playback time of all repetitions sent so far
+func (c *clip) played() time.Duration {
+	return time.Duration(c.loop) * c.length()
+}
+
+// clipDuration : Playback time of the clip (PCR, otherwise PTS), including the interval after the last timestamp. Without timestamps 0.5 seconds.
+func clipDuration(data []byte) int64 {
+
+	var pcrFirst, pcrLast, pcrCount int64
+	var ptsFirst, ptsLast, ptsCount int64
+
+	for i := 0; i < len(data); i += tsPacketSize {
+
+		var p = data[i : i+tsPacketSize]
+
+		if pcr := pcrField(p); pcr != nil {
+			var value = readPCR(pcr)
+			if pcrCount == 0 {
+				pcrFirst = value
+			}
+			pcrLast = value
+			pcrCount++
+		}
+
+		if pts, _ := timestampFields(p); pts != nil {
+			var value = readTimestamp(pts)
+			if ptsCount == 0 || value < ptsFirst {
+				ptsFirst = value
+			}
+			if value > ptsLast {
+				ptsLast = value
+			}
+			ptsCount++
+		}
+
+	}
+
+	switch {
+
+	case pcrCount > 1 && pcrLast > pcrFirst:
+		return (pcrLast - pcrFirst) * pcrCount / (pcrCount - 1)
+
+	case ptsCount > 1 && ptsLast > ptsFirst:
+		return (ptsLast - ptsFirst) * ptsCount / (ptsCount - 1)
+
+	}
+
+	return clockRate / 2
+}
+
+// pcrField : 6 bytes PCR of the adaptation field
+func pcrField(p []byte) []byte {
+
+	if p[3]&0x20 == 0 || p[4] < 7 || p[5]&0x10 == 0 {
+		return nil
+	}
+
+	return p[6:12]
+}
+
+// timestampFields : PTS and DTS (5 bytes each) of a PES header at the start of the payload
+func timestampFields(p []byte) (pts, dts []byte) {
+
+	if p[1]&0x40 == 0 || p[3]&0x10 == 0 {
+		return
+	}
+
+	var payload = p[4:]
+	if p[3]&0x20 != 0 {
+		if int(p[4])+1 >= len(payload) {
+			return
+		}
+		payload = payload[p[4]+1:]
+	}
+
+	// Start code, optional PES header ('10' marker bits)
+	if len(payload) < 19 || payload[0] != 0 || payload[1] != 0 || payload[2] != 1 || payload[6]&0xc0 != 0x80 {
+		return
+	}
+
+	switch payload[7] >> 6 {
+	case 2:
+		pts = payload[9:14]
+	case 3:
+		pts = payload[9:14]
+		dts = payload[14:19]
+	}
+
+	return
+}
+
+func readPCR(b []byte) int64 {
+	return int64(b[0])<<25 | int64(b[1])<<17 | int64(b[2])<<9 | int64(b[3])<<1 | int64(b[4])>>7
+}
+
+// writePCR : PCR base, the extension is not changed
+func writePCR(b []byte, base int64) {
+	b[0] = byte(base >> 25)
+	b[1] = byte(base >> 17)
+	b[2] = byte(base >> 9)
+	b[3] = byte(base >> 1)
+	b[4] = byte(base<<7)&0x80 | b[4]&0x7f
+}
+
+func readTimestamp(b []byte) int64 {
+	return int64(b[0]>>1&0x07)<<30 | int64(b[1])<<22 | int64(b[2]>>1)<<15 | int64(b[3])<<7 | int64(b[4]>>1)
+}
+
+// writeTimestamp : PTS / DTS, the prefix and the marker bits are not changed
+func writeTimestamp(b []byte, value int64) {
+	b[0] = b[0]&0xf1 | byte(value>>29)&0x0e
+	b[1] = byte(value >> 22)
+	b[2] = byte(value>>14)&0xfe | 0x01
+	b[3] = byte(value >> 7)
+	b[4] = byte(value<<1)&0xfe | 0x01
+}
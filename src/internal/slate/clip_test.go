@@ -0,0 +1,146 @@
+package slate
+
+import (
+	"bytes"
+	"context"
+	b64 "encoding/base64"
+	"os"
+	"testing"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"time"
+)
+
+// testClip : 10 video packets with PCR and PTS / DTS, 40 ms apart (0.4 seconds)
+func testClip() (data []byte) {
+
+	for i := 0; i < 10; i++ {
+
+		var p = make([]byte, tsPacketSize)
+		p[0], p[1], p[2], p[3] = 0x47, 0x41, 0x00, 0x30|byte(i+5)&0x0f // PID 0x100, adaptation field and payload
+
+		// Adaptation field with PCR
+		p[4], p[5] = 7, 0x10
+		writePCR(p[6:12], int64(90000+i*3600))
+
+		// PES header with PTS and DTS
+		var pes = p[12:]
+		copy(pes, []byte{0, 0, 1, 0xe0, 0, 0, 0x80, 0xc0, 10})
+		pes[9], pes[14] = 0x31, 0x11
+		writeTimestamp(pes[9:14], int64(99000+i*3600))
+		writeTimestamp(pes[14:19], int64(96000+i*3600))
+
+		data = append(data, p...)
+	}
+
+	return
+}
+
+func TestClip(t *testing.T) {
+
+	c, err := newClip(testClip())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.duration != 36000 || c.length() != 400*time.Millisecond {
+		t.Fatalf("unexpected duration: %d", c.duration)
+	}
+
+	var first = c.next()
+	var second = c.next()
+
+	for i := 0; i < 10; i++ {
+
+		var p1, p2 = first[i*tsPacketSize:], second[i*tsPacketSize:]
+
+		// Continuity counters continue across the repetitions
+		if p1[3]&0x0f != byte(i) || p2[3]&0x0f != byte(10+i)&0x0f {
+			t.Errorf("packet %d: unexpected continuity counters %d, %d", i, p1[3]&0x0f, p2[3]&0x0f)
+		}
+
+		if readPCR(p2[6:12])-readPCR(p1[6:12]) != 36000 {
+			t.Errorf("packet %d: PCR has not been moved by the duration of the clip", i)
+		}
+
+		pts1, dts1 := timestampFields(p1[:tsPacketSize])
+		pts2, dts2 := timestampFields(p2[:tsPacketSize])
+
+		if readTimestamp(pts1) != int64(99000+i*3600) || readTimestamp(pts2)-readTimestamp(pts1) != 36000 || readTimestamp(dts2)-readTimestamp(dts1) != 36000 {
+			t.Errorf("packet %d: unexpected PTS / DTS", i)
+		}
+
+		// Marker bits
+		if pts2[0]&0x31 != 0x31 || dts2[0]&0x11 != 0x11 || pts2[2]&1 != 1 || pts2[4]&1 != 1 {
+			t.Errorf("packet %d: marker bits have been changed", i)
+		}
+
+	}
+
+	if _, err = newClip([]byte("no transport stream")); err == nil {
+		t.Error("expected error for invalid data")
+	}
+
+}
+
+func TestUploadWrite(t *testing.T) {
+
+	config.System.Folder.Slates = t.TempDir() + string(os.PathSeparator)
+	defer func() { config.System.Folder.Slates = "" }()
+
+	if _, err := Get(Offline); err != ErrNoSlate {
+		t.Errorf("expected ErrNoSlate, got %v", err)
+	}
+
+	// PAT and the video packets
+	var pat = make([]byte, tsPacketSize)
+	copy(pat, []byte{0x47, 0x40, 0x00, 0x10, 0x00, 0x00, 0xb0, 0x0d, 0x00, 0x01, 0xc1, 0x00, 0x00, 0x00, 0x01, 0xe0, 0x20})
+	var upload = append(pat, testClip()...)
+
+	for _, invalid := range [][]byte{testClip(), upload[:len(upload)-1], []byte("<html></html>")} {
+		if err := Upload(Offline, "data:video/mp2t;base64,"+b64.StdEncoding.EncodeToString(invalid)); err == nil {
+			t.Errorf("invalid clip accepted: %d bytes", len(invalid))
+		}
+	}
+
+	if err := Upload(Offline, b64.StdEncoding.EncodeToString(make([]byte, MaxSize+tsPacketSize))); err == nil || err.Error() != cli.GetErrMsg(4015) {
+		t.Errorf("clip larger than %d bytes accepted: %v", MaxSize, err)
+	}
+
+	if err := Upload(Offline, "data:video/mp2t;base64,"+b64.StdEncoding.EncodeToString(upload)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Upload("unknown", "data:video/mp2t;base64,"+b64.StdEncoding.EncodeToString(upload)); err == nil {
+		t.Error("expected error for unknown slate")
+	}
+
+	if custom := GetCustom(); !custom[Offline] || custom[TunerLimit] {
+		t.Errorf("unexpected custom slates: %v", custom)
+	}
+
+	// 3 repetitions: 2 immediately, the third after the playback time of the first one
+	var buf bytes.Buffer
+	var start = time.Now()
+
+	if err := Write(context.Background(), &buf, Offline, 500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.Len() != 3*len(upload) {
+		t.Errorf("unexpected number of repetitions: %d bytes", buf.Len())
+	}
+
+	if elapsed := time.Since(start); elapsed < 750*time.Millisecond || elapsed > 1500*time.Millisecond {
+		t.Errorf("the slate has not been sent in real time: %s", elapsed)
+	}
+
+	if err := Remove(Offline); err != nil {
+		t.Fatal(err)
+	}
+
+	if custom := GetCustom(); custom[Offline] {
+		t.Error("the clip has not been removed")
+	}
+
+}
@@ -0,0 +1,163 @@
+package slate
+
+/*
+  Render an image as slate clip [ffmpeg]
+  -loop 1 -i stream-limit.jpg -c:v libx264 -t 1 -pix_fmt yuv420p -vf scale=1920:1080  stream-limit.ts
+*/
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/storage"
+	"threadfin/web"
+	"time"
+)
+
+// Slates: fallback videos for the clients. Uploaded clips are stored as <name>.ts in the slates folder (config.System.Folder.Slates).
+const (
+	TunerLimit    = "tuner-limit"    // All tuners are busy
+	Offline       = "offline"        // The channel does not send any data or has ended
+	ProviderError = "provider-error" // The streaming server could not be reached or returned an error
+	Unauthorized  = "unauthorized"   // The streaming server rejected the credentials (HTTP 401 / 403)
+)
+
+// Names : All slates, in the order of the web interface
+var Names = []string{TunerLimit, Offline, ProviderError, Unauthorized}
+
+// Duration : Duration of a slate before the client is disconnected
+const Duration = 30 * time.Second
+
+// MaxSize : Max. size of an uploaded clip
+const MaxSize = 20 << 20
+
+// ErrNoSlate : There is no clip for the slate, the client is disconnected without a slate
+var ErrNoSlate = errors.New("no clip for this slate")
+
+// Get : TS file of the slate. An uploaded clip is used before the embedded stream limit video, which is the default for the tuner limit.
+func Get(name string) (data []byte, err error) {
+
+	if !slices.Contains(Names, name) {
+		return nil, errors.New(cli.GetErrMsg(4012))
+	}
+
+	if data, err = os.ReadFile(file(name)); err == nil {
+		return
+	}
+
+	if name == TunerLimit {
+		if value, ok := web.WebUI["html/video/stream-limit.ts"]; ok {
+			return []byte(web.GetHTMLString(value.(string))), nil
+		}
+	}
+
+	return nil, ErrNoSlate
+}
+
+// GetCustom : Slates with an uploaded clip (web interface)
+func GetCustom() (custom map[string]bool) {
+
+	custom = make(map[string]bool)
+
+	for _, name := range Names {
+		custom[name] = storage.CheckFile(file(name)) == nil
+	}
+
+	return
+}
+
+// Upload : Saves an uploaded TS file (base64, data URL of the web interface) as clip of the slate.
+// The clip is checked before it is stored: max. MaxSize, complete TS packets and a PAT.
+func Upload(name, input string) (err error) {
+
+	if !slices.Contains(Names, name) {
+		return errors.New(cli.GetErrMsg(4012))
+	}
+
+	input = input[strings.IndexByte(input, ',')+1:]
+	if b64.StdEncoding.DecodedLen(len(input)) > MaxSize+2 {
+		return errors.New(cli.GetErrMsg(4015))
+	}
+
+	data, err := b64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return
+	}
+
+	if len(data) > MaxSize {
+		return errors.New(cli.GetErrMsg(4015))
+	}
+
+	if len(data)%tsPacketSize != 0 || !hasPAT(data) {
+		return errors.New(cli.GetErrMsg(4013))
+	}
+
+	if _, err = newClip(data); err != nil {
+		return
+	}
+
+	if err = storage.CheckFolder(config.System.Folder.Slates); err != nil {
+		return
+	}
+
+	return storage.WriteByteToFile(file(name), data)
+}
+
+// Remove : Removes the uploaded clip, the default of the slate is used again
+func Remove(name string) (err error) {
+
+	if !slices.Contains(Names, name) {
+		return errors.New(cli.GetErrMsg(4012))
+	}
+
+	if err = os.Remove(file(name)); errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+
+	return
+}
+
+// Write : Sends the slate for the given duration. The clip is repeated in real time with continuous timestamps, the players do not freeze on the last frame.
+func Write(ctx context.Context, w io.Writer, name string, duration time.Duration) (err error) {
+
+	data, err := Get(name)
+	if err != nil {
+		return
+	}
+
+	c, err := newClip(data)
+	if err != nil {
+		return
+	}
+
+	var start = time.Now()
+
+	for time.Since(start) < duration {
+
+		if _, err = w.Write(c.next()); err != nil {
+			return
+		}
+
+		// One repetition ahead of the playback time
+		var wait = time.Until(start.Add(c.played() - c.length()))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+	}
+
+	return
+}
+
+func file(name string) string {
+	return config.System.Folder.Slates + name + ".ts"
+}
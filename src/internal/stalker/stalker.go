@@ -0,0 +1,325 @@
+package stalker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/http"
+	"threadfin/internal/structs"
+)
+
+// SourceType : Provider parameter source.type of the M3U providers of a Stalker (MAG) portal
+const SourceType = "stalker"
+
+// scheme : Stream URLs of the playlist (stalker://<playlist ID>/<channel ID>?cmd=<cmd>), the portal creates the link at play time (Resolve)
+const scheme = "stalker://"
+
+// userAgent : The portals only answer MAG set-top boxes
+const userAgent = "Mozilla/5.0 (QtEmbedded; U; Linux; C) AppleWebKit/533.3 (KHTML, like Gecko) MAG200 stbapp ver: 2 rev: 250 Safari/533.3"
+
+// tokens : Tokens of the last handshake, key: API of the portal and MAC address
+var tokens sync.Map
+
+// Client : Stalker middleware portal, the set-top box is identified by the MAC address
+type Client struct {
+	URL   string // load.php or portal.php of the portal
+	MAC   string
+	Proxy string
+	token string
+}
+
+// NewClient : Client of the provider data (file.source, stalker.mac).
+// Links to the portal (e.g. http://portal.example/stalker_portal/c/ or http://portal.example/c/) are accepted.
+func NewClient(data map[string]any, proxy string) (client Client) {
+
+	var portal, _ = data["file.source"].(string)
+	var mac, _ = data["stalker.mac"].(string)
+
+	client.URL = apiURL(portal)
+	client.MAC = strings.ToUpper(strings.TrimSpace(mac))
+	client.Proxy = proxy
+
+	return
+}
+
+// Download : Playlist of the portal (genres and channels) for the local provider file
+func Download(playlistID string, client Client) (filename string, body []byte, err error) {
+
+	if err = client.Handshake(); err != nil {
+		return
+	}
+
+	genres, err := client.Genres()
+	if err != nil {
+		return
+	}
+
+	channels, err := client.Channels()
+	if err != nil {
+		return
+	}
+
+	if len(channels) == 0 {
+		err = fmt.Errorf("stalker: no channels for MAC %s", client.MAC)
+		return
+	}
+
+	if u, err := url.Parse(client.URL); err == nil {
+		filename = u.Host
+	}
+
+	cli.ShowInfo(fmt.Sprintf("Stalker:%s | MAC: %s | Channels: %d", filename, client.MAC, len(channels)))
+
+	body = Playlist(playlistID, client.logoURL, genres, channels)
+
+	return
+}
+
+// Handshake : New token of the portal, the profile of the set-top box activates the token
+func (client *Client) Handshake() (err error) {
+
+	var token structs.StalkerToken
+
+	client.token = ""
+
+	if err = client.api("stb", "handshake", url.Values{"token": {""}}, &token); err != nil {
+		return
+	}
+
+	if len(token.Token) == 0 {
+		return fmt.Errorf("stalker: handshake failed (%s)", client.URL)
+	}
+
+	client.token = token.Token
+
+	var profile map[string]any
+	if err = client.api("stb", "get_profile", url.Values{"hd": {"1"}, "stb_type": {"MAG250"}}, &profile); err != nil {
+		return
+	}
+
+	if len(profile) == 0 {
+		return fmt.Errorf("stalker: no profile for MAC %s", client.MAC)
+	}
+
+	tokens.Store(client.key(), client.token)
+
+	return
+}
+
+// Genres : Genres of the live channels
+func (client *Client) Genres() (genres []structs.StalkerGenre, err error) {
+	err = client.api("itv", "get_genres", nil, &genres)
+	return
+}
+
+// Channels : All live channels of the set-top box
+func (client *Client) Channels() (channels []structs.StalkerChannel, err error) {
+
+	var response structs.StalkerChannels
+
+	err = client.api("itv", "get_all_channels", nil, &response)
+	channels = response.Data
+
+	return
+}
+
+// CreateLink : Stream URL of the cmd of a channel, the links of most portals are only valid for a short time
+func (client *Client) CreateLink(cmd string) (streamURL string, err error) {
+
+	var link structs.StalkerLink
+
+	err = client.api("itv", "create_link", url.Values{"cmd": {cmd}, "series": {""}, "forced_storage": {"undefined"}, "disable_ad": {"0"}, "download": {"0"}}, &link)
+	if err != nil {
+		return
+	}
+
+	streamURL = commandURL(link.Cmd)
+	if len(streamURL) == 0 {
+		err = fmt.Errorf("stalker: no stream URL for %s", cmd)
+	}
+
+	return
+}
+
+// Playlist : M3U file of the channels, the stream URLs are resolved by the portal at play time
+func Playlist(playlistID string, logoURL func(string) string, genres []structs.StalkerGenre, channels []structs.StalkerChannel) []byte {
+
+	var groups = make(map[structs.XtreamValue]string, len(genres))
+	for _, genre := range genres {
+		groups[genre.ID] = genre.Title
+	}
+
+	var quotes = strings.NewReplacer(`"`, "", "\n", " ", "\r", "")
+	var playlist strings.Builder
+
+	playlist.WriteString("#EXTM3U\n")
+
+	for _, channel := range channels {
+
+		if len(channel.ID) == 0 || len(channel.Name) == 0 || len(channel.Cmd) == 0 {
+			continue
+		}
+
+		var name = quotes.Replace(channel.Name)
+
+		fmt.Fprintf(&playlist, "#EXTINF:-1 tvg-id=\"%s\" tvg-name=\"%s\" tvg-chno=\"%s\" tvg-logo=\"%s\" group-title=\"%s\",%s\n", quotes.Replace(string(channel.XMLTVID)), name, channel.Number, logoURL(channel.Logo), quotes.Replace(groups[channel.GenreID]), name)
+		fmt.Fprintf(&playlist, "%s\n", StreamURL(playlistID, string(channel.ID), channel.Cmd))
+
+	}
+
+	return []byte(playlist.String())
+}
+
+// StreamURL : Stream URL of a channel in the playlist
+func StreamURL(playlistID, channelID, cmd string) string {
+	return fmt.Sprintf("%s%s/%s?cmd=%s", scheme, playlistID, url.PathEscape(channelID), url.QueryEscape(cmd))
+}
+
+// IsStreamURL : Stream URL of a Stalker portal, the URL has to be resolved before the stream is opened
+func IsStreamURL(streamURL string) bool {
+	return strings.HasPrefix(streamURL, scheme)
+}
+
+// Resolve : Stream URL of the portal (create_link) for a stream URL of the playlist, other URLs are returned unchanged.
+// The token of the last handshake is used, if the portal rejects it a new handshake is made.
+func Resolve(streamURL string) (string, error) {
+
+	if !IsStreamURL(streamURL) {
+		return streamURL, nil
+	}
+
+	u, err := url.Parse(streamURL)
+	if err != nil {
+		return "", err
+	}
+
+	var cmd = u.Query().Get("cmd")
+
+	config.SystemMutex.Lock()
+	data, ok := config.Settings.Files.M3U[u.Host].(map[string]any)
+	var client Client
+	if ok {
+		client = NewClient(data, http.ProxyURL(data))
+	}
+	config.SystemMutex.Unlock()
+
+	if !ok {
+		return "", fmt.Errorf("stalker: playlist %s not found", u.Host)
+	}
+
+	if token, ok := tokens.Load(client.key()); ok {
+
+		client.token = token.(string)
+
+		if link, err := client.CreateLink(cmd); err == nil {
+			return link, nil
+		}
+
+	}
+
+	if err = client.Handshake(); err != nil {
+		return "", err
+	}
+
+	return client.CreateLink(cmd)
+}
+
+// api : Request to the portal, the data of the response is in "js"
+func (client *Client) api(kind, action string, parameters url.Values, v any) (err error) {
+
+	if len(client.MAC) == 0 {
+		return errors.New("stalker: MAC address is missing")
+	}
+
+	var query = url.Values{"type": {kind}, "action": {action}, "JsHttpRequest": {"1-xml"}}
+	for key, values := range parameters {
+		query[key] = values
+	}
+
+	var header = map[string]string{
+		"User-Agent":   userAgent,
+		"X-User-Agent": "Model: MAG250; Link: WiFi",
+		"Cookie":       fmt.Sprintf("mac=%s; stb_lang=en; timezone=UTC", url.QueryEscape(client.MAC)),
+		"Referer":      client.URL,
+	}
+
+	if len(client.token) > 0 {
+		header["Authorization"] = "Bearer " + client.token
+	}
+
+	body, err := http.Get(client.URL+"?"+query.Encode(), client.Proxy, header)
+	if err != nil {
+		return
+	}
+
+	var response = struct {
+		JS any `json:"js"`
+	}{JS: v}
+
+	if err = json.Unmarshal(body, &response); err != nil {
+		err = fmt.Errorf("stalker: %s: %w", action, err)
+	}
+
+	return
+}
+
+func (client *Client) key() string {
+	return client.URL + "|" + client.MAC
+}
+
+// logoURL : Logos of the channels are absolute URLs or paths on the server of the portal
+func (client *Client) logoURL(logo string) string {
+
+	if len(logo) == 0 || strings.Contains(logo, "://") {
+		return logo
+	}
+
+	u, err := url.Parse(client.URL)
+	if err != nil || !strings.HasPrefix(logo, "/") {
+		return ""
+	}
+
+	return u.Scheme + "://" + u.Host + logo
+}
+
+// apiURL : load.php of a stalker_portal installation, otherwise portal.php on the server of the portal
+func apiURL(portal string) string {
+
+	u, err := url.Parse(strings.TrimSpace(portal))
+	if err != nil || len(u.Host) == 0 {
+		return portal
+	}
+
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	switch {
+
+	case strings.HasSuffix(u.Path, ".php"):
+
+	case strings.Contains(u.Path, "/stalker_portal"):
+		u.Path = u.Path[:strings.Index(u.Path, "/stalker_portal")] + "/stalker_portal/server/load.php"
+
+	default:
+		u.Path = "/portal.php"
+
+	}
+
+	return u.String()
+}
+
+// commandURL : URL of the cmd of a channel, e.g. "ffmpeg http://server/stream" -> "http://server/stream"
+func commandURL(cmd string) string {
+
+	var fields = strings.Fields(cmd)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return fields[len(fields)-1]
+}
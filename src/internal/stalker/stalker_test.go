@@ -0,0 +1,181 @@
+package stalker
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/m3u-parser"
+)
+
+const testMAC = "00:1A:79:00:00:01"
+
+// fakePortal : load.php of a Stalker portal, every handshake creates a new token and the old tokens are rejected
+func fakePortal(t *testing.T) (portal *httptest.Server, handshakes *int) {
+
+	handshakes = new(int)
+
+	var mux = http.NewServeMux()
+
+	mux.HandleFunc("/stalker_portal/server/load.php", func(w http.ResponseWriter, r *http.Request) {
+
+		if cookie, err := r.Cookie("mac"); err != nil || cookie.Value != url.QueryEscape(testMAC) {
+			fmt.Fprint(w, `{"js":[]}`)
+			return
+		}
+
+		var query = r.URL.Query()
+		var token = fmt.Sprintf("TOKEN%d", *handshakes)
+
+		if query.Get("action") == "handshake" {
+			*handshakes++
+			fmt.Fprintf(w, `{"js":{"token":"TOKEN%d","random":"abc"}}`, *handshakes)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			fmt.Fprint(w, "Authorization failed.")
+			return
+		}
+
+		switch query.Get("action") {
+
+		case "get_profile":
+			fmt.Fprint(w, `{"js":{"id":1,"status":0}}`)
+
+		case "get_genres":
+			fmt.Fprint(w, `{"js":[{"id":"*","title":"All"},{"id":"1","title":"News"},{"id":2,"title":"Sports"}]}`)
+
+		case "get_all_channels":
+			fmt.Fprint(w, `{"js":{"total_items":3,"data":[
+				{"id":"101","name":"News 24","number":"1","cmd":"ffrt http://localhost/ch/101_","logo":"/stalker_portal/misc/logos/101.png","tv_genre_id":"1","xmltv_id":"news24.de"},
+				{"id":102,"name":"Sport \"HD\"","number":2,"cmd":"ffmpeg http://localhost/ch/102_","logo":"","tv_genre_id":2,"xmltv_id":null},
+				{"id":"103","name":"No Command","number":"3","cmd":"","tv_genre_id":"1"}
+			]}}`)
+
+		case "create_link":
+			fmt.Fprintf(w, `{"js":{"id":"1","cmd":"ffmpeg http://stream.example/live/%s?token=%s"}}`, strings.TrimSuffix(strings.TrimPrefix(strings.Fields(query.Get("cmd"))[1], "http://localhost/ch/"), "_"), token)
+
+		}
+
+	})
+
+	return httptest.NewServer(mux), handshakes
+}
+
+func TestNewClient(t *testing.T) {
+
+	var sources = map[string]string{
+		"http://portal.example/stalker_portal/c/":        "http://portal.example/stalker_portal/server/load.php",
+		"http://portal.example:8080/c/":                  "http://portal.example:8080/portal.php",
+		"http://portal.example/portal.php?type=stb":      "http://portal.example/portal.php",
+		"http://portal.example/stalker_portal/load.php":  "http://portal.example/stalker_portal/load.php",
+		"http://portal.example/path/stalker_portal/c/#x": "http://portal.example/path/stalker_portal/server/load.php",
+	}
+
+	for source, expected := range sources {
+
+		var client = NewClient(map[string]any{"file.source": source, "stalker.mac": " 00:1a:79:00:00:01"}, "")
+
+		if client.URL != expected || client.MAC != testMAC {
+			t.Errorf("%s: unexpected client %+v", source, client)
+		}
+
+	}
+
+}
+
+func TestDownload(t *testing.T) {
+
+	var portal, _ = fakePortal(t)
+	defer portal.Close()
+
+	var client = NewClient(map[string]any{"file.source": portal.URL + "/stalker_portal/c/", "stalker.mac": testMAC}, "")
+
+	_, body, err := Download("M1", client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channels, err := m3u.MakeInterfaceFromM3U(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(channels) != 2 {
+		t.Fatalf("expected 2 channels, got %d:\n%s", len(channels), body)
+	}
+
+	var news = channels[0].(map[string]string)
+	var expected = map[string]string{
+		"name":        "News 24",
+		"tvg-id":      "news24.de",
+		"tvg-chno":    "1",
+		"tvg-logo":    portal.URL + "/stalker_portal/misc/logos/101.png",
+		"group-title": "News",
+		"url":         "stalker://M1/101?cmd=ffrt+http%3A%2F%2Flocalhost%2Fch%2F101_",
+	}
+
+	for key, value := range expected {
+		if news[key] != value {
+			t.Errorf("%s: expected %q, got %q", key, value, news[key])
+		}
+	}
+
+	var sport = channels[1].(map[string]string)
+	if sport["name"] != "Sport HD" || sport["group-title"] != "Sports" || sport["tvg-chno"] != "2" || !IsStreamURL(sport["url"]) {
+		t.Errorf("unexpected channel: %v", sport)
+	}
+
+	client.MAC = "00:1A:79:00:00:02"
+	if _, _, err = Download("M1", client); err == nil {
+		t.Error("unknown MAC address accepted")
+	}
+
+}
+
+func TestResolve(t *testing.T) {
+
+	var portal, handshakes = fakePortal(t)
+	defer portal.Close()
+
+	config.Settings.Files.M3U = map[string]any{
+		"M1": map[string]any{"file.source": portal.URL + "/stalker_portal/c/", "stalker.mac": testMAC, "source.type": SourceType},
+	}
+	defer func() {
+		config.Settings.Files.M3U = nil
+	}()
+
+	if streamURL, err := Resolve("http://provider/stream.ts"); err != nil || streamURL != "http://provider/stream.ts" {
+		t.Errorf("URL changed: %s %v", streamURL, err)
+	}
+
+	var streamURL = StreamURL("M1", "101", "ffrt http://localhost/ch/101_")
+
+	link, err := Resolve(streamURL)
+	if err != nil || link != "http://stream.example/live/101?token=TOKEN1" {
+		t.Fatalf("unexpected link: %s %v", link, err)
+	}
+
+	// The token of the handshake is used again
+	link, err = Resolve(StreamURL("M1", "102", "ffmpeg http://localhost/ch/102_"))
+	if err != nil || link != "http://stream.example/live/102?token=TOKEN1" || *handshakes != 1 {
+		t.Fatalf("unexpected link: %s %v (handshakes: %d)", link, err, *handshakes)
+	}
+
+	// Expired token: new handshake
+	*handshakes++
+
+	link, err = Resolve(streamURL)
+	if err != nil || link != "http://stream.example/live/101?token=TOKEN3" {
+		t.Errorf("unexpected link: %s %v", link, err)
+	}
+
+	if _, err = Resolve(StreamURL("M2", "101", "ffrt http://localhost/ch/101_")); err == nil {
+		t.Error("unknown playlist resolved")
+	}
+
+}
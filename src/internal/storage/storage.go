@@ -94,8 +94,8 @@ func CheckVFSFolder(path string, vfs avfs.VFS) (err error) {
 
 		// If we are on Windows and the cache location path is NOT on C:\ we need to create the volume it is located on
 		// Failure to do so here will result in a panic error and the stream not playing
-		vm := vfs.(avfs.VolumeManager)
-		if vfs.OSType() == avfs.OsWindows && avfs.VolumeName(vfs, path) != "C:" {
+		// The OS filesystem (buffer on disk) has no volume manager, the volumes already exist
+		if vm, ok := vfs.(avfs.VolumeManager); ok && vfs.OSType() == avfs.OsWindows && avfs.VolumeName(vfs, path) != "C:" {
 			err = vm.VolumeAdd(path)
 			if err != nil {
 				return err
@@ -118,6 +118,21 @@ func CheckVFSFolder(path string, vfs avfs.VFS) (err error) {
 	return nil
 }
 
+// RemoveVFSFolder : Removes a folder from the virtual filesystem. Only folders inside of <root> are removed, never <root> itself or anything outside of it.
+func RemoveVFSFolder(path, root string, vfs avfs.VFS) (err error) {
+
+	if len(path) == 0 || len(root) == 0 {
+		return fmt.Errorf("refusing to remove folder: empty path (path: '%s', root: '%s')", path, root)
+	}
+
+	rel, err := filepath.Rel(filepath.Clean(root), filepath.Clean(path))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) || filepath.IsAbs(rel) {
+		return fmt.Errorf("refusing to remove folder: %s is not inside of %s", path, root)
+	}
+
+	return vfs.RemoveAll(filepath.Clean(path))
+}
+
 // FSIsNotExistErr : Returns true whether the <err> is known to report that a file or directory does not exist,
 // including virtual file system errors
 func FSIsNotExistErr(err error) bool {
@@ -1,11 +1,10 @@
 package stream
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
-	"sort"
-	"strconv"
 	"strings"
 	"threadfin/internal/buffer"
 	"threadfin/internal/cli"
@@ -13,15 +12,15 @@ import (
 	"threadfin/internal/config"
 	"threadfin/internal/crypt"
 	"threadfin/internal/provider"
+	"threadfin/internal/slate"
 	"threadfin/internal/storage"
 	"threadfin/internal/structs"
 	"threadfin/internal/tuner"
-	"threadfin/internal/utilities"
 	"threadfin/web"
 	"time"
 )
 
-func Buffering(playlistID string, streamingURL string, backupStream1 *structs.BackupStream, backupStream2 *structs.BackupStream, backupStream3 *structs.BackupStream, channelName string, w http.ResponseWriter, r *http.Request) {
+func Buffering(playlistID string, streamingURL string, backupChannels []structs.BackupStream, bufferProfile string, channelName string, w http.ResponseWriter, r *http.Request) {
 	time.Sleep(time.Duration(config.Settings.BufferTimeout) * time.Millisecond)
 
 	var playlist structs.Playlist
@@ -82,8 +81,11 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 		config.SystemMutex.Unlock()
 
 		playlist.Buffer = playListBuffer
+		playlist.BufferProfile = provider.GetProviderParameter(playlist.PlaylistID, playlistType, "buffer.profile")
 
 		playlist.Tuner = tuner.Get(playlistID, playlistType)
+		playlist.TunerPool = tuner.GetPool(playlistID, playlistType)
+		playlist.Accounts = provider.GetAccounts(playlistID, playlistType)
 
 		playlist.PlaylistName = provider.GetProviderParameter(playlist.PlaylistID, playlistType, "name")
 
@@ -93,16 +95,25 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 		playlist.HttpUserOrigin = provider.GetProviderParameter(playlist.PlaylistID, playlistType, "http_headers.origin")
 		playlist.HttpUserReferer = provider.GetProviderParameter(playlist.PlaylistID, playlistType, "http_headers.referer")
 
+		// Streams have priority over the background probes of the channels
+		tuner.StopProbes(playlistID, playlist.TunerPool)
+
+		// Check if the tuner pool allows another stream (providers with the same account)
+		if tuner.PoolLimitReached(playlist.TunerPool) {
+			tunerLimit(playlist, tuner.PoolPlaylists(playlist.TunerPool), streamingURL, backupChannels, bufferProfile, channelName, w, r)
+			return
+		}
+
 		// Create default values for the stream
 		streamID = CreateID(playlist.Streams, client.GetIP(r), r.UserAgent())
 
 		currentClient.Connection += 1
 
 		currentStream.URL = streamingURL
-		currentStream.BackupChannel1 = backupStream1
-		currentStream.BackupChannel2 = backupStream2
-		currentStream.BackupChannel3 = backupStream3
+		currentStream.BufferProfile = bufferProfile
 		currentStream.ChannelName = channelName
+		currentStream.Account = tuner.GetAccount(playlist)
+		currentStream.BackupChannels = backupAccounts(playlist, currentStream.Account, backupChannels)
 		currentStream.Status = false
 
 		playlist.Streams[streamID] = currentStream
@@ -123,13 +134,13 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 			currentStream = playlist.Streams[id]
 			currentClient = playlist.Clients[id]
 
-			currentStream.BackupChannel1 = backupStream1
-			currentStream.BackupChannel2 = backupStream2
-			currentStream.BackupChannel3 = backupStream3
+			currentStream.BackupChannels = backupChannels
+			currentStream.BufferProfile = bufferProfile
 			currentStream.ChannelName = channelName
 			currentStream.Status = false
 
-			if streamingURL == currentStream.URL {
+			// Clients with different buffer profiles (?profile=) use separate buffer sessions
+			if streamingURL == currentStream.URL && bufferProfile == playlist.Streams[id].BufferProfile {
 
 				streamID = id
 				newStream = false
@@ -164,41 +175,18 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 		// New stream for an already active playlist
 		if newStream {
 
-			// Check if the playlist allows another stream (Tuner)
-			if len(playlist.Streams) >= playlist.Tuner {
-				// If there are backup URLs, use them
-				if backupStream1 != nil {
-					Buffering(backupStream1.PlaylistID, backupStream1.URL, nil, backupStream2, backupStream3, channelName, w, r)
-				} else if backupStream2 != nil {
-					Buffering(backupStream2.PlaylistID, backupStream2.URL, nil, nil, backupStream3, channelName, w, r)
-				} else if backupStream3 != nil {
-					Buffering(backupStream3.PlaylistID, backupStream3.URL, nil, nil, nil, channelName, w, r)
-				}
-
-				cli.ShowInfo(fmt.Sprintf("Streaming Status:Playlist: %s - No new connections available. Tuner = %d", playlist.PlaylistName, playlist.Tuner))
-
-				if value, ok := web.WebUI["html/video/stream-limit.ts"]; ok {
-
-					content := web.GetHTMLString(value.(string))
-
-					w.WriteHeader(200)
-					w.Header().Set("Content-type", "video/mpeg")
-					w.Header().Set("Content-Length:", "0")
-
-					for i := 1; i < 60; i++ {
-						_ = i
-						_, err := w.Write([]byte(content))
-						if err != nil {
-							cli.ShowError(err, 0)
-							return
-						}
-
-						time.Sleep(time.Duration(500) * time.Millisecond)
-					}
+			// Streams have priority over the background probes of the channels
+			tuner.StopProbes(playlistID, playlist.TunerPool)
 
-					return
-				}
+			// Check if the playlist allows another stream (Tuner)
+			if len(playlist.Streams)+tuner.Probes(playlistID) >= playlist.Tuner {
+				tunerLimit(playlist, []string{playlistID}, streamingURL, backupChannels, bufferProfile, channelName, w, r)
+				return
+			}
 
+			// Check if the tuner pool allows another stream (providers with the same account)
+			if tuner.PoolLimitReached(playlist.TunerPool) {
+				tunerLimit(playlist, tuner.PoolPlaylists(playlist.TunerPool), streamingURL, backupChannels, bufferProfile, channelName, w, r)
 				return
 			}
 
@@ -213,9 +201,9 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 			currentStream.URL = streamingURL
 			currentStream.ChannelName = channelName
 			currentStream.Status = false
-			currentStream.BackupChannel1 = backupStream1
-			currentStream.BackupChannel2 = backupStream2
-			currentStream.BackupChannel3 = backupStream3
+			currentStream.BufferProfile = bufferProfile
+			currentStream.Account = tuner.GetAccount(playlist)
+			currentStream.BackupChannels = backupAccounts(playlist, currentStream.Account, backupChannels)
 
 			playlist.Streams[streamID] = currentStream
 			playlist.Clients[streamID] = currentClient
@@ -234,12 +222,14 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 		// New buffer is needed
 		currentStream = playlist.Streams[streamID]
 		currentStream.MD5 = crypt.GetMD5(streamingURL)
+		if len(bufferProfile) > 0 {
+			currentStream.MD5 = crypt.GetMD5(streamingURL + "#" + bufferProfile)
+		}
 		currentStream.Folder = playlist.Folder + currentStream.MD5 + string(os.PathSeparator)
 		currentStream.PlaylistID = playlistID
 		currentStream.PlaylistName = playlist.PlaylistName
-		currentStream.BackupChannel1 = backupStream1
-		currentStream.BackupChannel2 = backupStream2
-		currentStream.BackupChannel3 = backupStream3
+		currentStream.BackupChannels = backupChannels
+		currentStream.BufferProfile = bufferProfile
 
 		playlist.Streams[streamID] = currentStream
 
@@ -247,14 +237,15 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 		config.BufferInformation.Store(playlistID, playlist)
 		config.Lock.Unlock()
 
-		switch playlist.Buffer {
-
-		case "ffmpeg", "vlc":
-			go buffer.ThirdParty(streamID, playlistID, false, 0)
-
-		default:
-			break
+		if _, err := buffer.NewBroadcaster(playlistID+currentStream.MD5, currentStream.Folder); err != nil {
+			cli.ShowError(err, 0)
+			client.KillConnection(streamID, playlistID, true)
+			web.HttpStatusError(w, 500)
+			return
+		}
 
+		if buffer.IsBufferType(playlist.Buffer) {
+			go buffer.Start(streamID, playlistID)
 		}
 
 		cli.ShowInfo(fmt.Sprintf("Streaming Status 1:Playlist: %s - Tuner: %d / %d", playlist.PlaylistName, len(playlist.Streams), playlist.Tuner))
@@ -265,10 +256,23 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 
 	}
 
+	// Session registry (API / web interface), the context ends when the session is terminated
+	session, ctx := client.NewSession(r, client.User(r), playlistID, streamID, channelName)
+	defer session.Close()
+
 	w.WriteHeader(200)
 
 	for { //Loop 1: Wait until the first segment has been downloaded through the buffer
 
+		if ctx.Err() != nil {
+			if session.Preempted() {
+				sendSlate(w, r, slate.TunerLimit)
+				return
+			}
+			client.KillConnection(streamID, playlistID, false)
+			return
+		}
+
 		if p, ok := config.BufferInformation.Load(playlistID); ok {
 
 			var playlist = p.(structs.Playlist)
@@ -285,7 +289,7 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 
 						var clients = c.(structs.ClientConnection)
 
-						if clients.Error != nil || (timeOut > 200 && (playlist.Streams[streamID].BackupChannel1 == nil && playlist.Streams[streamID].BackupChannel2 == nil && playlist.Streams[streamID].BackupChannel3 == nil)) {
+						if clients.Error != nil || (timeOut > 200 && len(playlist.Streams[streamID].BackupChannels) == 0) {
 							client.KillConnection(streamID, stream.PlaylistID, false)
 							return
 						}
@@ -295,159 +299,76 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 					continue
 				}
 
-				var oldSegments []string
+				broadcaster, ok := buffer.GetBroadcaster(playlistID + stream.MD5)
+				if !ok {
+					client.KillConnection(streamID, playlistID, false)
+					return
+				}
 
-				for { // Loop 2: Temporary files are present, data can be sent to the client
+				var reader = broadcaster.NewReader()
+				defer reader.Close()
 
-					// Monitor HTTP client connection
+				var data = make([]byte, 32*1024)
 
-					ctx := r.Context()
-					if ok {
+				for { // Loop 2: Data is available in the broadcaster and can be sent to the client
 
-						select {
+					// Monitor HTTP client connection
+					select {
 
-						case <-ctx.Done():
-							client.KillConnection(streamID, playlistID, false)
+					case <-ctx.Done():
+						// The stream has already been stopped by PreemptStream
+						if session.Preempted() {
+							sendSlate(w, r, slate.TunerLimit)
 							return
-
-						default:
-							if c, ok := config.BufferClients.Load(playlistID + stream.MD5); ok {
-
-								var clients = c.(structs.ClientConnection)
-								if clients.Error != nil {
-									cli.ShowError(clients.Error, 0)
-									client.KillConnection(streamID, playlistID, false)
-									return
-								}
-
-							} else {
-
-								return
-
-							}
-
 						}
-
-					}
-
-					if _, err := config.BufferVFS.Stat(stream.Folder); storage.FSIsNotExistErr(err) {
 						client.KillConnection(streamID, playlistID, false)
 						return
-					}
 
-					var tmpFiles = getBufTmpFiles(&stream)
-					//fmt.Println("Buffer Loop:", stream.Connection)
+					default:
+						if c, ok := config.BufferClients.Load(playlistID + stream.MD5); ok {
+
+							var clients = c.(structs.ClientConnection)
+							if clients.Error != nil {
+								cli.ShowError(clients.Error, 0)
+								client.KillConnection(streamID, playlistID, false)
+								return
+							}
 
-					for _, f := range tmpFiles {
+						} else {
 
-						if _, err := config.BufferVFS.Stat(stream.Folder); storage.FSIsNotExistErr(err) {
-							client.KillConnection(streamID, playlistID, false)
 							return
-						}
 
-						oldSegments = append(oldSegments, f)
+						}
 
-						var fileName = stream.Folder + f
+					}
 
-						file, err := config.BufferVFS.Open(fileName)
-						if err != nil {
-							debug = fmt.Sprintf("Buffer Open (%s)", fileName)
-							cli.ShowDebug(debug, 2)
+					n, err := reader.ReadTimeout(data, time.Duration(1000)*time.Millisecond)
+					if err != nil {
+						debug = fmt.Sprintf("Buffer Status:Broadcaster closed (%s)", stream.ChannelName)
+						cli.ShowDebug(debug, 2)
+						if session.Preempted() {
+							sendSlate(w, r, slate.TunerLimit)
 							return
 						}
-						defer func() {
-							err = file.Close()
-						}()
-
-						if err == nil {
-							l, err := file.Stat()
-							if err == nil {
-
-								debug = fmt.Sprintf("Buffer Status:Send to client (%s)", fileName)
-								cli.ShowDebug(debug, 2)
-
-								var buffer = make([]byte, int(l.Size()))
-								_, err = file.Read(buffer)
-
-								if err == nil {
-
-									_, err = file.Seek(0, 0)
-									if err != nil {
-										cli.ShowError(err, 0)
-										client.KillConnection(streamID, playlistID, false)
-										return
-									}
-
-									if !streaming {
-
-										contentType := http.DetectContentType(buffer)
-										_ = contentType
-										w.Header().Set("Content-type", contentType)
-										w.Header().Set("Content-Length", "0")
-										w.Header().Set("Connection", "close")
-
-									}
-
-									/*
-									   // HDHR Header
-									   w.Header().Set("Cache-Control", "no-cache")
-									   w.Header().Set("Pragma", "no-cache")
-									   w.Header().Set("transferMode.dlna.org", "Streaming")
-									*/
-
-									_, err := w.Write(buffer)
-
-									if err != nil {
-										err = file.Close()
-										if err != nil {
-											cli.ShowError(err, 0)
-										}
-										client.KillConnection(streamID, playlistID, false)
-										return
-									}
-
-									err = file.Close()
-									if err != nil {
-										cli.ShowError(err, 0)
-										client.KillConnection(streamID, playlistID, false)
-										return
-									}
-									streaming = true
-
-								}
-
-								err = file.Close()
-								if err != nil {
-									cli.ShowError(err, 0)
-									client.KillConnection(streamID, playlistID, false)
-									return
-								}
-							}
-
-							var n = utilities.IndexOfString(f, oldSegments)
-
-							if n > 20 {
-
-								var fileToRemove = stream.Folder + oldSegments[0]
-								if err = config.BufferVFS.RemoveAll(storage.GetPlatformFile(fileToRemove)); err != nil {
-									cli.ShowError(err, 4007)
-								}
-								oldSegments = append(oldSegments[:0], oldSegments[0+1:]...)
-
-							}
+						client.KillConnection(streamID, playlistID, false)
+						return
+					}
 
-						}
+					if n == 0 {
+						continue
+					}
 
-						err = file.Close()
-						if err != nil {
-							cli.ShowError(err, 0)
-							client.KillConnection(streamID, playlistID, false)
-							return
-						}
+					if _, err = w.Write(data[:n]); err != nil {
+						client.KillConnection(streamID, playlistID, false)
+						return
 					}
 
-					if len(tmpFiles) == 0 {
-						time.Sleep(time.Duration(100) * time.Millisecond)
+					session.AddBytes(n)
+
+					if !streaming {
+						debug = fmt.Sprintf("Buffer Status:Send to client (%s)", stream.ChannelName)
+						cli.ShowDebug(debug, 2)
+						streaming = true
 					}
 
 				} // End Loop 2
@@ -468,49 +389,73 @@ func Buffering(playlistID string, streamingURL string, backupStream1 *structs.Ba
 
 }
 
-func getBufTmpFiles(stream *structs.ThisStream) (tmpFiles []string) {
+// backupAccounts : Backup channels with the credentials of an account of their playlist (provider: accounts). A backup channel of the same playlist
+// uses the account of the stream, a backup channel of another playlist the account with the most free connections of that playlist.
+func backupAccounts(playlist structs.Playlist, account int, backupChannels []structs.BackupStream) (backups []structs.BackupStream) {
 
-	var tmpFolder = stream.Folder
-	var fileIDs []float64
+	for _, backup := range backupChannels {
 
-	if _, err := config.BufferVFS.Stat(tmpFolder); !storage.FSIsNotExistErr(err) {
-
-		files, err := config.BufferVFS.ReadDir(storage.GetPlatformPath(tmpFolder))
-		if err != nil {
-			cli.ShowError(err, 000)
-			return
-		}
+		switch {
 
-		if len(files) > 2 {
+		case backup.PlaylistID == playlist.PlaylistID:
+			backup.URL = buffer.AccountURL(backup.URL, playlist.Accounts, account)
 
-			for _, file := range files {
+		case len(backup.PlaylistID) > 0:
 
-				var fileID = strings.ReplaceAll(file.Name(), ".ts", "")
-				var f, err = strconv.ParseFloat(fileID, 64)
+			var playlistType = "m3u"
+			if strings.HasPrefix(backup.PlaylistID, "H") {
+				playlistType = "hdhr"
+			}
 
-				if err == nil {
-					fileIDs = append(fileIDs, f)
-				}
+			var other = structs.Playlist{PlaylistID: backup.PlaylistID, Accounts: provider.GetAccounts(backup.PlaylistID, playlistType)}
+			if len(other.Accounts) == 0 {
+				break
+			}
 
+			if p, ok := config.BufferInformation.Load(backup.PlaylistID); ok {
+				other.Streams = p.(structs.Playlist).Streams
 			}
 
-			sort.Float64s(fileIDs)
-			fileIDs = fileIDs[:len(fileIDs)-1]
+			backup.URL = buffer.AccountURL(backup.URL, other.Accounts, tuner.GetAccount(other))
 
-			for _, file := range fileIDs {
+		}
 
-				var fileName = fmt.Sprintf("%d.ts", int64(file))
+		backups = append(backups, backup)
 
-				if utilities.IndexOfString(fileName, stream.OldSegments) == -1 {
-					tmpFiles = append(tmpFiles, fileName)
-					stream.OldSegments = append(stream.OldSegments, fileName)
-				}
+	}
 
-			}
+	return
+}
 
-		}
+// tunerLimit : No tuner is available for a new stream. The backup channels are used in the order of the list, otherwise a request with a higher priority
+// stops the running stream of the playlists with the lowest priority (settings: stream.priorities). Without a free tuner the client receives the tuner limit slate.
+func tunerLimit(playlist structs.Playlist, playlistIDs []string, streamingURL string, backupChannels []structs.BackupStream, bufferProfile string, channelName string, w http.ResponseWriter, r *http.Request) {
 
+	// If there are backup URLs, use them in the order of the list
+	if len(backupChannels) > 0 {
+		Buffering(backupChannels[0].PlaylistID, backupChannels[0].URL, backupChannels[1:], bufferProfile, channelName, w, r)
+		return
+	}
+
+	if client.PreemptStream(playlistIDs, client.GetPriority(r, client.User(r), channelName)) {
+		Buffering(playlist.PlaylistID, streamingURL, backupChannels, bufferProfile, channelName, w, r)
+		return
+	}
+
+	cli.ShowInfo(fmt.Sprintf("Streaming Status:Playlist: %s - No new connections available. Tuner = %d", playlist.PlaylistName, playlist.Tuner))
+
+	w.WriteHeader(200)
+	w.Header().Set("Content-type", "video/mpeg")
+	w.Header().Set("Content-Length:", "0")
+
+	sendSlate(w, r, slate.TunerLimit)
+}
+
+// sendSlate : Sends the slate to the client (e.g. tuner limit), without a clip for the slate the connection is closed
+func sendSlate(w http.ResponseWriter, r *http.Request, name string) {
+
+	if err := slate.Write(r.Context(), w, name, slate.Duration); err != nil && !errors.Is(err, slate.ErrNoSlate) && r.Context().Err() == nil {
+		cli.ShowError(err, 0)
 	}
 
-	return
 }
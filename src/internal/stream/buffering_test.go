@@ -0,0 +1,40 @@
+package stream
+
+import (
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/provider"
+	"threadfin/internal/structs"
+)
+
+func TestBackupAccounts(t *testing.T) {
+
+	config.Settings.Files.M3U = map[string]any{
+		"M1": map[string]any{"buffer": "threadfin", "accounts": "alice:secret:2 | bob:pw:3"},
+		"M2": map[string]any{"buffer": "threadfin", "accounts": "carol:c:1 | dave:d:2"},
+		"M3": map[string]any{"buffer": "threadfin", "tuner": "1"},
+	}
+	defer func() { config.Settings.Files.M3U = nil }()
+
+	var playlist = structs.Playlist{PlaylistID: "M1", Accounts: provider.GetAccounts("M1", "m3u")}
+
+	var backups = backupAccounts(playlist, 1, []structs.BackupStream{
+		{PlaylistID: "M1", URL: "http://one.example.com/live/alice/secret/1.ts"},
+		{PlaylistID: "M2", URL: "http://two.example.com/live/carol/c/2.ts"},
+		{PlaylistID: "M3", URL: "http://three.example.com/live/alice/secret/3.ts"},
+	})
+
+	// Same playlist: account of the stream, other playlist: account with the most free connections, without accounts the URL is kept
+	var expected = []string{"http://one.example.com/live/bob/pw/1.ts", "http://two.example.com/live/dave/d/2.ts", "http://three.example.com/live/alice/secret/3.ts"}
+
+	if len(backups) != len(expected) {
+		t.Fatalf("expected %d backup channels, got %d", len(expected), len(backups))
+	}
+
+	for i, backup := range backups {
+		if backup.URL != expected[i] {
+			t.Errorf("backup channel %d: expected %s, got %s", i, expected[i], backup.URL)
+		}
+	}
+
+}
@@ -0,0 +1,154 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/crypt"
+	"threadfin/internal/hls"
+	"threadfin/internal/structs"
+	"threadfin/web"
+	"time"
+)
+
+// hlsIdleTimeout : The HLS output is stopped if no client has requested the playlist or a segment within this time
+const hlsIdleTimeout = 30 * time.Second
+
+// hlsStartSegments : Segments in the playlist before it is sent to the first client
+const hlsStartSegments = 2
+
+// hlsOutput : Virtual client of the buffer that cuts the stream into HLS segments (http.ResponseWriter for Buffering)
+type hlsOutput struct {
+	*hls.Segmenter
+	header      http.Header
+	lastRequest atomic.Int64
+}
+
+func (o *hlsOutput) Header() http.Header {
+	return o.header
+}
+
+func (o *hlsOutput) WriteHeader(int) {}
+
+// HLS : HLS output of a buffered stream, /stream/<id>/index.m3u8 and the segments /stream/<id>/<sequence>.ts. The first request starts the buffer
+// with a virtual client (tuner limit, priorities and backup channels as for MPEG-TS clients). Without requests the output is stopped after the idle timeout.
+func HLS(urlID string, streamInfo structs.StreamInfo, bufferProfile string, file string, w http.ResponseWriter, r *http.Request) {
+
+	var key = urlID + "#" + bufferProfile
+	var output *hlsOutput
+
+	if o, ok := config.HLSOutputs.Load(key); ok {
+		output = o.(*hlsOutput)
+	} else if file == "index.m3u8" {
+		output = startHLSOutput(key, streamInfo, bufferProfile, r)
+	} else {
+		web.HttpStatusError(w, 404)
+		return
+	}
+
+	output.lastRequest.Store(time.Now().UnixNano())
+
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	switch {
+
+	case file == "index.m3u8":
+
+		if !output.Wait(hlsStartSegments, 20*time.Second) {
+			web.HttpStatusError(w, 503)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+		_, _ = w.Write([]byte(output.Playlist(r.URL.RawQuery)))
+
+	case strings.HasSuffix(file, ".ts"):
+
+		sequence, err := strconv.ParseInt(strings.TrimSuffix(file, ".ts"), 10, 64)
+		if err != nil {
+			web.HttpStatusError(w, 404)
+			return
+		}
+
+		data, ok := output.Segment(sequence)
+		if !ok {
+			web.HttpStatusError(w, 404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "video/mp2t")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		_, _ = w.Write(data)
+
+	default:
+		web.HttpStatusError(w, 404)
+
+	}
+
+}
+
+// PreviewURL : HLS playlist of an active channel for the preview in the web interface
+func PreviewURL(playlistID, url string) (previewURL string, err error) {
+
+	var urlID = crypt.GetMD5(fmt.Sprintf("%s-%s", playlistID, url))
+
+	if _, err = GetStreamInfo(urlID); err != nil {
+		return "", errors.New(cli.GetErrMsg(4014))
+	}
+
+	return fmt.Sprintf("/stream/%s/index.m3u8", urlID), nil
+}
+
+// startHLSOutput : Starts the buffer for the HLS output, the request of the first client is used for the session (IP, user agent, priority)
+func startHLSOutput(key string, streamInfo structs.StreamInfo, bufferProfile string, r *http.Request) *hlsOutput {
+
+	var output = &hlsOutput{Segmenter: hls.NewSegmenter(), header: make(http.Header)}
+	output.lastRequest.Store(time.Now().UnixNano())
+
+	if o, loaded := config.HLSOutputs.LoadOrStore(key, output); loaded {
+		return o.(*hlsOutput)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cli.ShowInfo(fmt.Sprintf("Streaming Status:Channel: %s - HLS output", streamInfo.Name))
+
+	go func() {
+
+		Buffering(streamInfo.PlaylistID, streamInfo.URL, streamInfo.BackupChannels, bufferProfile, streamInfo.Name, output, r.Clone(ctx))
+
+		cancel()
+		output.End()
+		config.HLSOutputs.CompareAndDelete(key, output)
+
+	}()
+
+	go func() {
+
+		var ticker = time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if time.Since(time.Unix(0, output.lastRequest.Load())) >= hlsIdleTimeout {
+					cli.ShowInfo(fmt.Sprintf("Streaming Status:Channel: %s - HLS output stopped, no client", streamInfo.Name))
+					cancel()
+					return
+				}
+			}
+		}
+
+	}()
+
+	return output
+}
@@ -57,7 +57,7 @@ func CreateID(stream map[int]structs.ThisStream, ip, userAgent string) (streamID
 }
 
 // Provider Streaming-URL zu Threadfin Streaming-URL konvertieren
-func CreateURL(streamingType, playlistID, channelNumber, channelName, url string, backup_channel_1 *structs.BackupStream, backup_channel_2 *structs.BackupStream, backup_channel_3 *structs.BackupStream) (streamingURL string, err error) {
+func CreateURL(streamingType, playlistID, channelNumber, channelName, url string, backupChannels []structs.BackupStream, bufferProfile string) (streamingURL string, err error) {
 
 	var streamInfo structs.StreamInfo
 	var serverProtocol string
@@ -71,11 +71,22 @@ func CreateURL(streamingType, playlistID, channelNumber, channelName, url string
 	if s, ok := config.Data.Cache.StreamingURLS[urlID]; ok {
 		streamInfo = s
 
+		// The backup channels and the buffer profile can be changed in the mapping without a new streaming URL.
+		// Callers without these values (HDHomeRun lineup of the playlists) keep the values of the cache.
+		if len(backupChannels) > 0 {
+			streamInfo.BackupChannels = backupChannels
+		}
+
+		if len(bufferProfile) > 0 {
+			streamInfo.BufferProfile = bufferProfile
+		}
+
+		config.Data.Cache.StreamingURLS[urlID] = streamInfo
+
 	} else {
 		streamInfo.URL = url
-		streamInfo.BackupChannel1 = backup_channel_1
-		streamInfo.BackupChannel2 = backup_channel_2
-		streamInfo.BackupChannel3 = backup_channel_3
+		streamInfo.BackupChannels = backupChannels
+		streamInfo.BufferProfile = bufferProfile
 		streamInfo.Name = channelName
 		streamInfo.PlaylistID = playlistID
 		streamInfo.ChannelNumber = channelNumber
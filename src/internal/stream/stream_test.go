@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"path"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+)
+
+func TestCreateURL(t *testing.T) {
+
+	config.Data.Cache.StreamingURLS = nil
+	defer func() { config.Data.Cache.StreamingURLS = nil }()
+
+	var backups = []structs.BackupStream{{PlaylistID: "M2", URL: "http://two.example.com/1.ts"}}
+
+	streamingURL, err := CreateURL("M3U", "M1", "1000", "One", "http://one.example.com/1.ts", backups, "hd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// HDHomeRun lineup of the same stream without backup channels and buffer profile
+	if _, err = CreateURL("DVR", "M1", "1000", "One", "http://one.example.com/1.ts", nil, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	var streamInfo = config.Data.Cache.StreamingURLS[path.Base(streamingURL)]
+	if len(streamInfo.BackupChannels) != 1 || streamInfo.BufferProfile != "hd" {
+		t.Errorf("backup channels and buffer profile of the cache changed: %+v", streamInfo)
+	}
+
+	// New values of the mapping
+	if _, err = CreateURL("M3U", "M1", "1000", "One", "http://one.example.com/1.ts", append(backups, structs.BackupStream{PlaylistID: "M3"}), "sd"); err != nil {
+		t.Fatal(err)
+	}
+
+	if streamInfo = config.Data.Cache.StreamingURLS[path.Base(streamingURL)]; len(streamInfo.BackupChannels) != 2 || streamInfo.BufferProfile != "sd" {
+		t.Errorf("backup channels and buffer profile not updated: %+v", streamInfo)
+	}
+
+}
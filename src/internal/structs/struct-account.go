@@ -0,0 +1,8 @@
+package structs
+
+// ProviderAccount : Credentials of a provider with several logins (provider: accounts). The streaming URLs of the playlist contain the credentials of the first account.
+type ProviderAccount struct {
+	Username string
+	Password string // Password or token
+	Tuner    int    // Connection limit of the account
+}
@@ -13,6 +13,9 @@ type Playlist struct {
 	HttpUserOrigin  string
 	HttpUserReferer string
 	Buffer          string
+	BufferProfile   string
+	TunerPool       string // Shared connection limit with other providers (settings: tuner.pools)
+	Accounts        []ProviderAccount
 
 	Clients map[int]ThisClient
 	Streams map[int]ThisStream
@@ -23,6 +26,44 @@ type ThisClient struct {
 	Connection int
 }
 
+// StreamSession : Client of a stream (session registry), for the API and the web interface
+type StreamSession struct {
+	ID          string        `json:"id"`
+	PlaylistID  string        `json:"playlistID"`
+	StreamID    int           `json:"streamID"`
+	ChannelName string        `json:"channelName"`
+	IP          string        `json:"ip"`
+	UserAgent   string        `json:"userAgent"`
+	User        string        `json:"user,omitempty"` // Verified by the authentication (Xtream Codes API)
+	Start       time.Time     `json:"start"`
+	BytesSent   int64         `json:"bytesSent"`
+	Bitrate     int64         `json:"bitrate"`  // bit/s
+	Priority    int           `json:"priority"` // Tuner preemption (settings: stream.priorities)
+	Health      *StreamHealth `json:"health,omitempty"`
+}
+
+// StreamHealth : MPEG-TS metrics of a stream, analyzed in the buffer
+type StreamHealth struct {
+	Status         string      `json:"status"` // ok, frozen, corrupt
+	Packets        int64       `json:"packets"`
+	CCErrors       int64       `json:"ccErrors"`
+	PCRGaps        int64       `json:"pcrGaps"`
+	PCRMaxInterval int64       `json:"pcrMaxInterval"` // ms
+	NullRatio      float64     `json:"nullRatio"`
+	SyncErrors     int64       `json:"syncErrors"`
+	PIDs           []PIDHealth `json:"pids"`
+}
+
+// PIDHealth : PID of a stream (PID table)
+type PIDHealth struct {
+	PID        uint16 `json:"pid"`
+	Type       string `json:"type"` // pat, pmt, video, audio, data
+	StreamType byte   `json:"streamType,omitempty"`
+	Packets    int64  `json:"packets"`
+	CCErrors   int64  `json:"ccErrors"`
+	Scrambled  bool   `json:"scrambled,omitempty"`
+}
+
 // ThisStream : Enthält Informationen zu dem abzuspielenden Stream einer Playlist
 type ThisStream struct {
 	ChannelName      string
@@ -34,9 +75,9 @@ type ThisStream struct {
 	PlaylistName     string
 	Status           bool
 	URL              string
-	BackupChannel1   *BackupStream
-	BackupChannel2   *BackupStream
-	BackupChannel3   *BackupStream
+	BackupChannels   []BackupStream
+	BufferProfile    string
+	Account          int // Index in Playlist.Accounts
 
 	Segment []Segment
 
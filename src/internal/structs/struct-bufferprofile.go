@@ -0,0 +1,9 @@
+package structs
+
+// BufferProfile : Named FFmpeg / VLC profile (settings: buffer.profiles), selectable per provider and per channel
+type BufferProfile struct {
+	Name    string   `json:"name"`
+	Path    string   `json:"path"`    // Command, empty: FFmpeg / VLC path from the settings
+	Options string   `json:"options"` // Options template, [URL] is replaced by the streaming URL
+	Headers []string `json:"headers"` // Additional HTTP headers (Name: Value)
+}
@@ -0,0 +1,29 @@
+package structs
+
+// Enigma2Services : OpenWebif /api/getservices, without sRef the TV bouquets of the receiver, otherwise the services of the bouquet
+type Enigma2Services struct {
+	Services []Enigma2Service `json:"services"`
+}
+
+// Enigma2Service : Bouquet or service of the receiver, markers are services with the flag 64 in the service reference
+type Enigma2Service struct {
+	ServiceReference string `json:"servicereference"`
+	ServiceName      string `json:"servicename"`
+}
+
+// Enigma2Events : OpenWebif /api/epgmulti?bRef=, the EPG of all services of a bouquet
+type Enigma2Events struct {
+	Events []Enigma2Event `json:"events"`
+}
+
+// Enigma2Event : Program of the receiver EPG
+type Enigma2Event struct {
+	ID               XtreamValue `json:"id"`
+	ServiceReference string      `json:"sref"`
+	BeginTimestamp   XtreamValue `json:"begin_timestamp"`
+	Duration         XtreamValue `json:"duration_sec"`
+	Title            string      `json:"title"`
+	ShortDescription string      `json:"shortdesc"`
+	LongDescription  string      `json:"longdesc"`
+	Genre            string      `json:"genre"`
+}
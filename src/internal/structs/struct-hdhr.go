@@ -57,5 +57,8 @@ type Lineup []interface {
 type LineupStream struct {
 	GuideName   string `json:"GuideName"`
 	GuideNumber string `json:"GuideNumber"`
+	VideoCodec  string `json:"VideoCodec,omitempty"`
+	AudioCodec  string `json:"AudioCodec,omitempty"`
+	HD          int    `json:"HD,omitempty"`
 	URL         string `json:"URL"`
 }
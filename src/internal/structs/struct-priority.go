@@ -0,0 +1,10 @@
+package structs
+
+// StreamPriority : Priority rule for the tuner preemption (settings: stream.priorities). All conditions that are set must match, the highest priority of the matching rules is used.
+type StreamPriority struct {
+	User      string `json:"user"`      // Username, verified by the authentication of the Xtream Codes API
+	IP        string `json:"ip"`        // IP address or range (CIDR)
+	UserAgent string `json:"userAgent"` // Part of the User-Agent, case-insensitive
+	Channel   string `json:"channel"`   // Channel name
+	Priority  int    `json:"priority"`
+}
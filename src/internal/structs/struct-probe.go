@@ -0,0 +1,23 @@
+package structs
+
+import "time"
+
+// ChannelProbe : Result of the background probe of an active channel (settings: probe.interval)
+type ChannelProbe struct {
+	Name         string    `json:"name"`
+	PlaylistID   string    `json:"playlistID"`
+	URL          string    `json:"url"`
+	Time         time.Time `json:"time"`
+	Reachable    bool      `json:"reachable"`
+	Error        string    `json:"error,omitempty"`
+	TTFB         int64     `json:"ttfb"` // Time to first byte, ms
+	Resolution   string    `json:"resolution,omitempty"`
+	FrameRate    string    `json:"frameRate,omitempty"`
+	VideoCodec   string    `json:"videoCodec,omitempty"`
+	AudioCodec   string    `json:"audioCodec,omitempty"`
+	AudioChannel string    `json:"audioChannel,omitempty"`
+	Failures     int       `json:"failures"`  // Failed probes in a row
+	Probes       int       `json:"probes"`    // All probes of the channel
+	Successes    int       `json:"successes"` // Successful probes of the channel
+	Dead         bool      `json:"dead"`
+}
@@ -0,0 +1,36 @@
+package structs
+
+// StalkerToken : type=stb&action=handshake, the responses of the Stalker portal (load.php, portal.php) are sent in "js"
+type StalkerToken struct {
+	Token  string `json:"token"`
+	Random string `json:"random"`
+}
+
+// StalkerGenre : type=itv&action=get_genres
+type StalkerGenre struct {
+	ID    XtreamValue `json:"id"`
+	Title string      `json:"title"`
+}
+
+// StalkerChannels : type=itv&action=get_all_channels
+type StalkerChannels struct {
+	TotalItems XtreamValue      `json:"total_items"`
+	Data       []StalkerChannel `json:"data"`
+}
+
+// StalkerChannel : Channel of the Stalker portal, cmd is passed to create_link to get the stream URL
+type StalkerChannel struct {
+	ID      XtreamValue `json:"id"`
+	Name    string      `json:"name"`
+	Number  XtreamValue `json:"number"`
+	Cmd     string      `json:"cmd"`
+	Logo    string      `json:"logo"`
+	GenreID XtreamValue `json:"tv_genre_id"`
+	XMLTVID XtreamValue `json:"xmltv_id"`
+}
+
+// StalkerLink : type=itv&action=create_link
+type StalkerLink struct {
+	ID  XtreamValue `json:"id"`
+	Cmd string      `json:"cmd"`
+}
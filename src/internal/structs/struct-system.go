@@ -35,6 +35,10 @@ type SystemStruct struct {
 		Path           string
 	}
 
+	Command struct {
+		DefaultOptions string
+	}
+
 	File struct {
 		Authentication string
 		M3U            string
@@ -65,6 +69,7 @@ type SystemStruct struct {
 		Data         string
 		ImagesCache  string
 		ImagesUpload string
+		Slates       string
 		Temp         string
 	}
 
@@ -183,41 +188,49 @@ type Filter struct {
 
 // XEPGChannelStruct : XEPG Struktur
 type XEPGChannelStruct struct {
-	FileM3UID          string        `json:"_file.m3u.id"`
-	FileM3UName        string        `json:"_file.m3u.name"`
-	FileM3UPath        string        `json:"_file.m3u.path"`
-	GroupTitle         string        `json:"group-title"`
-	Name               string        `json:"name"`
-	TvgID              string        `json:"tvg-id"`
-	TvgLogo            string        `json:"tvg-logo"`
-	TvgName            string        `json:"tvg-name"`
-	TvgChno            string        `json:"tvg-chno"`
-	URL                string        `json:"url"`
-	UUIDKey            string        `json:"_uuid.key"`
-	UUIDValue          string        `json:"_uuid.value,omitempty"`
-	Values             string        `json:"_values"`
-	XActive            bool          `json:"x-active"`
-	XCategory          string        `json:"x-category"`
-	XChannelID         string        `json:"x-channelID"`
-	XEPG               string        `json:"x-epg"`
-	XGroupTitle        string        `json:"x-group-title"`
-	XMapping           string        `json:"x-mapping"`
-	XmltvFile          string        `json:"x-xmltv-file"`
-	XPpvExtra          string        `json:"x-ppv-extra"`
-	XBackupChannel1    string        `json:"x-backup-channel-1"`
-	XBackupChannel2    string        `json:"x-backup-channel-2"`
-	XBackupChannel3    string        `json:"x-backup-channel-3"`
-	XHideChannel       bool          `json:"x-hide-channel"`
-	XName              string        `json:"x-name"`
-	XUpdateChannelIcon bool          `json:"x-update-channel-icon"`
-	XUpdateChannelName bool          `json:"x-update-channel-name"`
-	XDescription       string        `json:"x-description"`
-	Live               bool          `json:"live"`
-	IsBackupChannel    bool          `json:"is_backup_channel"`
-	BackupChannel1     *BackupStream `json:"backup_channel_1"`
-	BackupChannel2     *BackupStream `json:"backup_channel_2"`
-	BackupChannel3     *BackupStream `json:"backup_channel_3"`
-	ChannelUniqueID    string        `json:"channelUniqueID"`
+	FileM3UID          string         `json:"_file.m3u.id"`
+	FileM3UName        string         `json:"_file.m3u.name"`
+	FileM3UPath        string         `json:"_file.m3u.path"`
+	GroupTitle         string         `json:"group-title"`
+	Name               string         `json:"name"`
+	TvgID              string         `json:"tvg-id"`
+	TvgLogo            string         `json:"tvg-logo"`
+	TvgName            string         `json:"tvg-name"`
+	TvgChno            string         `json:"tvg-chno"`
+	URL                string         `json:"url"`
+	UUIDKey            string         `json:"_uuid.key"`
+	UUIDValue          string         `json:"_uuid.value,omitempty"`
+	Values             string         `json:"_values"`
+	XActive            bool           `json:"x-active"`
+	XCategory          string         `json:"x-category"`
+	XChannelID         string         `json:"x-channelID"`
+	XEPG               string         `json:"x-epg"`
+	XGroupTitle        string         `json:"x-group-title"`
+	XMapping           string         `json:"x-mapping"`
+	XmltvFile          string         `json:"x-xmltv-file"`
+	XPpvExtra          string         `json:"x-ppv-extra"`
+	XBackupChannels    []string       `json:"x-backup-channels"`
+	XBufferProfile     string         `json:"x-buffer-profile"`
+	XHideChannel       bool           `json:"x-hide-channel"`
+	XName              string         `json:"x-name"`
+	XUpdateChannelIcon bool           `json:"x-update-channel-icon"`
+	XUpdateChannelName bool           `json:"x-update-channel-name"`
+	XDescription       string         `json:"x-description"`
+	XResolution        string         `json:"x-resolution,omitempty"` // Stream metadata of the last probe (ffprobe)
+	XFrameRate         string         `json:"x-frame-rate,omitempty"`
+	XVideoCodec        string         `json:"x-video-codec,omitempty"`
+	XAudioCodec        string         `json:"x-audio-codec,omitempty"`
+	XAudioChannel      string         `json:"x-audio-channel,omitempty"`
+	XChannelGroup      string         `json:"x-channel-group,omitempty"`        // XEPG ID of the primary channel of the group (settings: channel.groups)
+	XGroupBackup       bool           `json:"x-channel-group-backup,omitempty"` // Hidden by the channel group, the stream is a backup of the primary channel
+	XProbeDeactivated  bool           `json:"x-probe-deactivated,omitempty"`    // Deactivated by the probes (probe.dead.action: deactivate), activated again after a successful probe
+	Live               bool           `json:"live"`
+	IsBackupChannel    bool           `json:"is_backup_channel"`
+	BackupChannels     []BackupStream `json:"backup_channels"`
+	ChannelUniqueID    string         `json:"channelUniqueID"`
+
+	Attributes map[string]string `json:"attributes,omitempty"` // Attributes of the provider besides the tvg attributes (catchup, tvg-shift, tvg-rec, ...)
+	Directives []string          `json:"directives,omitempty"` // Directives of the provider (#EXTVLCOPT, #KODIPROP, #EXTGRP, ...)
 }
 
 // M3UChannelStructXEPG : M3U Struktur für XEPG
@@ -262,14 +275,13 @@ type StreamingURLS struct {
 
 // StreamInfo : Informationen zum Kanal für die streaming URL
 type StreamInfo struct {
-	ChannelNumber  string        `json:"channelNumber"`
-	Name           string        `json:"name"`
-	PlaylistID     string        `json:"playlistID"`
-	URL            string        `json:"url"`
-	BackupChannel1 *BackupStream `json:"backup_channel_1"`
-	BackupChannel2 *BackupStream `json:"backup_channel_2"`
-	BackupChannel3 *BackupStream `json:"backup_channel_3"`
-	URLid          string        `json:"urlID"`
+	ChannelNumber  string         `json:"channelNumber"`
+	Name           string         `json:"name"`
+	PlaylistID     string         `json:"playlistID"`
+	URL            string         `json:"url"`
+	BackupChannels []BackupStream `json:"backup_channels"`
+	BufferProfile  string         `json:"bufferProfile"`
+	URLid          string         `json:"urlID"`
 }
 
 // Notification : Notifikationen im Webinterface
@@ -283,27 +295,31 @@ type Notification struct {
 
 // SettingsStruct : Inhalt der settings.json
 type SettingsStruct struct {
-	API               bool     `json:"api"`
-	AuthenticationAPI bool     `json:"authentication.api"`
-	AuthenticationM3U bool     `json:"authentication.m3u"`
-	AuthenticationPMS bool     `json:"authentication.pms"`
-	AuthenticationWEB bool     `json:"authentication.web"`
-	AuthenticationXML bool     `json:"authentication.xml"`
-	BackupKeep        int      `json:"backup.keep"`
-	BackupPath        string   `json:"backup.path"`
-	Branch            string   `json:"git.branch,omitempty"`
-	Buffer            string   `json:"buffer"`
-	BufferSize        int      `json:"buffer.size.kb"`
-	BufferTimeout     float64  `json:"buffer.timeout"`
-	CacheImages       bool     `json:"cache.images"`
-	EpgSource         string   `json:"epgSource"`
-	FFmpegOptions     string   `json:"ffmpeg.options"`
-	FFmpegPath        string   `json:"ffmpeg.path"`
-	FFmpegForceHttp   bool     `json:"ffmpeg.forceHttp"`
-	VLCOptions        string   `json:"vlc.options"`
-	VLCPath           string   `json:"vlc.path"`
-	FileM3U           []string `json:"file,omitempty"`  // Beim Wizard wird die M3U in ein Slice gespeichert
-	FileXMLTV         []string `json:"xmltv,omitempty"` // Altes Speichersystem der Provider XML Datei Slice (Wird für die Umwandlung auf das neue benötigt)
+	API                bool     `json:"api"`
+	AuthenticationAPI  bool     `json:"authentication.api"`
+	AuthenticationM3U  bool     `json:"authentication.m3u"`
+	AuthenticationPMS  bool     `json:"authentication.pms"`
+	AuthenticationWEB  bool     `json:"authentication.web"`
+	AuthenticationXML  bool     `json:"authentication.xml"`
+	BackupKeep         int      `json:"backup.keep"`
+	BackupPath         string   `json:"backup.path"`
+	Branch             string   `json:"git.branch,omitempty"`
+	Buffer             string   `json:"buffer"`
+	BufferSize         int      `json:"buffer.size.kb"`
+	BufferStallTimeout int      `json:"buffer.stall.timeout"`
+	BufferStreamMaxMB  int      `json:"buffer.stream.max.mb"`
+	BufferTimeout      float64  `json:"buffer.timeout"`
+	CacheImages        bool     `json:"cache.images"`
+	CommandOptions     string   `json:"command.options"`
+	CommandPath        string   `json:"command.path"`
+	EpgSource          string   `json:"epgSource"`
+	FFmpegOptions      string   `json:"ffmpeg.options"`
+	FFmpegPath         string   `json:"ffmpeg.path"`
+	FFmpegForceHttp    bool     `json:"ffmpeg.forceHttp"`
+	VLCOptions         string   `json:"vlc.options"`
+	VLCPath            string   `json:"vlc.path"`
+	FileM3U            []string `json:"file,omitempty"`  // Beim Wizard wird die M3U in ein Slice gespeichert
+	FileXMLTV          []string `json:"xmltv,omitempty"` // Altes Speichersystem der Provider XML Datei Slice (Wird für die Umwandlung auf das neue benötigt)
 
 	Files struct {
 		HDHR  map[string]interface{} `json:"hdhr"`
@@ -311,14 +327,28 @@ type SettingsStruct struct {
 		XMLTV map[string]interface{} `json:"xmltv"`
 	} `json:"files"`
 
+	BufferProfiles map[string]BufferProfile `json:"buffer.profiles"`
+
+	StreamPriorities []StreamPriority     `json:"stream.priorities"`
+	TunerPools       map[string]TunerPool `json:"tuner.pools"`
+
+	AuthenticationXtream      bool                  `json:"authentication.xtream"`
+	ChannelGroups             bool                  `json:"channel.groups"`
+	ChannelGroupRules         string                `json:"channel.groups.rules"` // Order of the rules for the primary channel: resolution, priority, availability
 	FilesUpdate               bool                  `json:"files.update"`
 	Filter                    map[int64]interface{} `json:"filter"`
 	Key                       string                `json:"key,omitempty"`
 	Language                  string                `json:"language"`
 	LogEntriesRAM             int                   `json:"log.entries.ram"`
 	M3U8AdaptiveBandwidthMBPS int                   `json:"m3u8.adaptive.bandwidth.mbps"`
+	M3UAttributesAllow        string                `json:"m3u.attributes.allow"` // Attributes and directives of the provider in the M3U file, separated by commas (*: all)
+	M3UAttributesDeny         string                `json:"m3u.attributes.deny"`  // Attributes and directives that are never written, the deny list has priority
 	MappingFirstChannel       float64               `json:"mapping.first.channel"`
 	Port                      string                `json:"port"`
+	ProbeConcurrency          int                   `json:"probe.concurrency"`
+	ProbeDeadAction           string                `json:"probe.dead.action"` // -, deactivate, backup
+	ProbeFailures             int                   `json:"probe.failures"`    // Failed probes in a row until a channel is dead
+	ProbeInterval             int                   `json:"probe.interval"`    // Minutes, 0: no background probes
 	SSDP                      bool                  `json:"ssdp"`
 	TempPath                  string                `json:"temp.path"`
 	Tuner                     int                   `json:"tuner"`
@@ -327,6 +357,7 @@ type SettingsStruct struct {
 	UserAgent                 string                `json:"user.agent"`
 	UUID                      string                `json:"uuid"`
 	UDPxy                     string                `json:"udpxy"`
+	UDPInterface              string                `json:"udp.interface"`
 	Version                   string                `json:"version"`
 	XepgReplaceMissingImages  bool                  `json:"xepg.replace.missing.images"`
 	XepgReplaceChannelTitle   bool                  `json:"xepg.replace.channel.title"`
@@ -359,6 +390,7 @@ type FFProbeOutput struct {
 
 type ProbeStream struct {
 	CodecType     string `json:"codec_type"`
+	CodecName     string `json:"codec_name,omitempty"`
 	Width         int    `json:"width,omitempty"`
 	Height        int    `json:"height,omitempty"`
 	RFrameRate    string `json:"r_frame_rate,omitempty"`
@@ -0,0 +1,7 @@
+package structs
+
+// TunerPool : Connection limit shared by several providers with the same account (settings: tuner.pools), selectable per provider
+type TunerPool struct {
+	Name  string `json:"name"`
+	Tuner int    `json:"tuner"` // Total number of streams of all providers in the pool
+}
@@ -24,22 +24,36 @@ type RequestStruct struct {
 		AuthenticationPMS        *bool     `json:"authentication.pms,omitempty"`
 		AuthenticationWEP        *bool     `json:"authentication.web,omitempty"`
 		AuthenticationXML        *bool     `json:"authentication.xml,omitempty"`
+		AuthenticationXtream     *bool     `json:"authentication.xtream,omitempty"`
 		BackupKeep               *int      `json:"backup.keep,omitempty"`
 		BackupPath               *string   `json:"backup.path,omitempty"`
 		Buffer                   *string   `json:"buffer,omitempty"`
 		BufferSize               *int      `json:"buffer.size.kb,omitempty"`
+		BufferStallTimeout       *int      `json:"buffer.stall.timeout,omitempty"`
+		BufferStreamMaxMB        *int      `json:"buffer.stream.max.mb,omitempty"`
 		BufferTimeout            *float64  `json:"buffer.timeout,omitempty"`
 		CacheImages              *bool     `json:"cache.images,omitempty"`
+		CommandOptions           *string   `json:"command.options,omitempty"`
+		CommandPath              *string   `json:"command.path,omitempty"`
 		EpgSource                *string   `json:"epgSource,omitempty"`
 		FFmpegOptions            *string   `json:"ffmpeg.options,omitempty"`
 		FFmpegPath               *string   `json:"ffmpeg.path,omitempty"`
 		FfmpegForceHttp          *bool     `json:"ffmpeg.forceHttp,omitempty"`
 		VLCOptions               *string   `json:"vlc.options,omitempty"`
 		VLCPath                  *string   `json:"vlc.path,omitempty"`
+		ChannelGroups            *bool     `json:"channel.groups,omitempty"`
+		ChannelGroupRules        *string   `json:"channel.groups.rules,omitempty"`
 		FilesUpdate              *bool     `json:"files.update,omitempty"`
+		M3UAttributesAllow       *string   `json:"m3u.attributes.allow,omitempty"`
+		M3UAttributesDeny        *string   `json:"m3u.attributes.deny,omitempty"`
+		ProbeConcurrency         *int      `json:"probe.concurrency,omitempty"`
+		ProbeDeadAction          *string   `json:"probe.dead.action,omitempty"`
+		ProbeFailures            *int      `json:"probe.failures,omitempty"`
+		ProbeInterval            *int      `json:"probe.interval,omitempty"`
 		TempPath                 *string   `json:"temp.path,omitempty"`
 		Tuner                    *int      `json:"tuner,omitempty"`
 		UDPxy                    *string   `json:"udpxy,omitempty"`
+		UDPInterface             *string   `json:"udp.interface,omitempty"`
 		Update                   *[]string `json:"update,omitempty"`
 		UserAgent                *string   `json:"user.agent,omitempty"`
 		XepgReplaceMissingImages *bool     `json:"xepg.replace.missing.images,omitempty"`
@@ -60,6 +74,10 @@ type RequestStruct struct {
 		Dummy                    *bool     `json:"dummy,omitempty"`
 		DummyChannel             *string   `json:"dummyChannel,omitempty"`
 		IgnoreFilters            *bool     `json:"ignoreFilters,omitempty"`
+
+		BufferProfiles   *map[string]BufferProfile `json:"buffer.profiles,omitempty"`
+		StreamPriorities *[]StreamPriority         `json:"stream.priorities,omitempty"`
+		TunerPools       *map[string]TunerPool     `json:"tuner.pools,omitempty"`
 	} `json:"settings,omitempty"`
 
 	// Upload Logo
@@ -85,6 +103,13 @@ type RequestStruct struct {
 
 	// Probe Url
 	ProbeURL string `json:"probeURL,omitempty"`
+
+	// Channel preview (HLS output): provider and streaming URL of the channel
+	PreviewPlaylistID string `json:"previewPlaylistID,omitempty"`
+	PreviewURL        string `json:"previewURL,omitempty"`
+
+	// Stream session (killSession)
+	SessionID string `json:"sessionID,omitempty"`
 }
 
 // ResponseStruct : Antworten an den Client (WEB)
@@ -125,21 +150,25 @@ type ResponseStruct struct {
 		}
 	} `json:"data"`
 
-	Alert               string                 `json:"alert,omitempty"`
-	ConfigurationWizard bool                   `json:"configurationWizard"`
-	Error               string                 `json:"err,omitempty"`
-	Log                 WebScreenLogStruct     `json:"log"`
-	LogoURL             string                 `json:"logoURL,omitempty"`
-	OpenLink            string                 `json:"openLink,omitempty"`
-	OpenMenu            string                 `json:"openMenu,omitempty"`
-	Reload              bool                   `json:"reload,omitempty"`
-	Settings            SettingsStruct         `json:"settings"`
-	Status              bool                   `json:"status"`
-	Token               string                 `json:"token,omitempty"`
-	Users               map[string]interface{} `json:"users,omitempty"`
-	Wizard              int                    `json:"wizard,omitempty"`
-	XEPG                map[string]interface{} `json:"xepg"`
-	ProbeInfo           ProbeInfoStruct        `json:"probeInfo,omitempty"`
+	Alert               string                  `json:"alert,omitempty"`
+	ConfigurationWizard bool                    `json:"configurationWizard"`
+	Error               string                  `json:"err,omitempty"`
+	Log                 WebScreenLogStruct      `json:"log"`
+	LogoURL             string                  `json:"logoURL,omitempty"`
+	OpenLink            string                  `json:"openLink,omitempty"`
+	OpenMenu            string                  `json:"openMenu,omitempty"`
+	Reload              bool                    `json:"reload,omitempty"`
+	Settings            SettingsStruct          `json:"settings"`
+	Status              bool                    `json:"status"`
+	Token               string                  `json:"token,omitempty"`
+	Users               map[string]interface{}  `json:"users,omitempty"`
+	Wizard              int                     `json:"wizard,omitempty"`
+	XEPG                map[string]interface{}  `json:"xepg"`
+	ProbeInfo           ProbeInfoStruct         `json:"probeInfo,omitempty"`
+	PreviewURL          string                  `json:"previewURL,omitempty"`
+	Probes              map[string]ChannelProbe `json:"probes,omitempty"` // Background probes of the active channels (key: XEPG ID)
+	Sessions            []StreamSession         `json:"sessions"`
+	Slates              map[string]bool         `json:"slates,omitempty"` // Slates with an uploaded clip
 
 	Notification map[string]Notification `json:"notification,omitempty"`
 }
@@ -148,11 +177,14 @@ type ProbeInfoStruct struct {
 	Resolution   string `json:"resolution,omitempty"`
 	FrameRate    string `json:"frameRate,omitempty"`
 	AudioChannel string `json:"audioChannel,omitempty"`
+	VideoCodec   string `json:"videoCodec,omitempty"` // ffprobe codec name, e.g. h264
+	AudioCodec   string `json:"audioCodec,omitempty"`
 }
 
 // APIRequestStruct : Anfrage über die API Schnittstelle
 type APIRequestStruct struct {
 	Cmd      string `json:"cmd"`
+	ID       string `json:"id"`
 	Password string `json:"password"`
 	Token    string `json:"token"`
 	Username string `json:"username"`
@@ -172,6 +204,8 @@ type APIResponseStruct struct {
 	URLXepg          string `json:"url.xepg,omitempty"`
 	VersionAPI       string `json:"version.api,omitempty"`
 	VersionThreadfin string `json:"version.threadfin,omitempty"`
+
+	Sessions []StreamSession `json:"sessions,omitempty"`
 }
 
 // WebScreenLogStruct : Logs werden im RAM gespeichert und für das Webinterface bereitgestellt
@@ -0,0 +1,144 @@
+package structs
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// XtreamValue : Value of the Xtream Codes API, the Stalker portals and OpenWebif, the servers send IDs and numbers as JSON numbers or strings
+type XtreamValue string
+
+// UnmarshalJSON : Accepts strings, numbers, booleans and null
+func (v *XtreamValue) UnmarshalJSON(data []byte) (err error) {
+
+	var value any
+	if err = json.Unmarshal(data, &value); err != nil {
+		return
+	}
+
+	switch value := value.(type) {
+
+	case string:
+		*v = XtreamValue(value)
+
+	case float64:
+		*v = XtreamValue(strconv.FormatFloat(value, 'f', -1, 64))
+
+	case bool:
+		*v = XtreamValue(strconv.FormatBool(value))
+
+	default:
+		*v = ""
+
+	}
+
+	return
+}
+
+// Int : Numeric value, 0 if the value is not a number
+func (v XtreamValue) Int() int {
+	i, _ := strconv.Atoi(string(v))
+	return i
+}
+
+// XtreamAccount : player_api.php without action
+type XtreamAccount struct {
+	UserInfo struct {
+		Username             string        `json:"username"`
+		Password             string        `json:"password"`
+		Auth                 XtreamValue   `json:"auth"`
+		Status               string        `json:"status"`
+		ExpDate              XtreamValue   `json:"exp_date"`
+		ActiveConnections    XtreamValue   `json:"active_cons"`
+		MaxConnections       XtreamValue   `json:"max_connections"`
+		AllowedOutputFormats []XtreamValue `json:"allowed_output_formats"`
+	} `json:"user_info"`
+	ServerInfo struct {
+		URL            string      `json:"url"`
+		Port           XtreamValue `json:"port"`
+		HTTPSPort      XtreamValue `json:"https_port"`
+		ServerProtocol string      `json:"server_protocol"`
+		Timezone       string      `json:"timezone"`
+	} `json:"server_info"`
+}
+
+// XtreamCategory : player_api.php?action=get_live_categories
+type XtreamCategory struct {
+	CategoryID   XtreamValue `json:"category_id"`
+	CategoryName string      `json:"category_name"`
+	ParentID     XtreamValue `json:"parent_id"`
+}
+
+// XtreamStream : player_api.php?action=get_live_streams
+type XtreamStream struct {
+	Num               XtreamValue `json:"num"`
+	Name              string      `json:"name"`
+	StreamType        string      `json:"stream_type"`
+	StreamID          XtreamValue `json:"stream_id"`
+	StreamIcon        string      `json:"stream_icon"`
+	EPGChannelID      XtreamValue `json:"epg_channel_id"`
+	Added             XtreamValue `json:"added"`
+	CategoryID        XtreamValue `json:"category_id"`
+	TVArchive         XtreamValue `json:"tv_archive"`          // 1: Catch-up
+	TVArchiveDuration XtreamValue `json:"tv_archive_duration"` // Days
+	DirectSource      string      `json:"direct_source"`
+}
+
+// XtreamEPGListing : player_api.php?action=get_short_epg, title and description are base64 encoded
+type XtreamEPGListing struct {
+	ID             XtreamValue `json:"id"`
+	EPGID          XtreamValue `json:"epg_id"`
+	Title          string      `json:"title"`
+	Lang           string      `json:"lang"`
+	Start          string      `json:"start"`
+	End            string      `json:"end"`
+	Description    string      `json:"description"`
+	ChannelID      XtreamValue `json:"channel_id"`
+	StartTimestamp XtreamValue `json:"start_timestamp"`
+	StopTimestamp  XtreamValue `json:"stop_timestamp"`
+	NowPlaying     XtreamValue `json:"now_playing,omitempty"` // get_simple_data_table
+	HasArchive     XtreamValue `json:"has_archive,omitempty"` // get_simple_data_table
+}
+
+// XtreamServerAccount : player_api.php without action of the Threadfin Xtream Codes API
+type XtreamServerAccount struct {
+	UserInfo struct {
+		Username             string   `json:"username"`
+		Password             string   `json:"password"`
+		Message              string   `json:"message"`
+		Auth                 int      `json:"auth"`
+		Status               string   `json:"status"`
+		ExpDate              *string  `json:"exp_date"` // null: unlimited
+		IsTrial              string   `json:"is_trial"`
+		ActiveConnections    string   `json:"active_cons"`
+		CreatedAt            string   `json:"created_at"`
+		MaxConnections       string   `json:"max_connections"`
+		AllowedOutputFormats []string `json:"allowed_output_formats"`
+	} `json:"user_info"`
+	ServerInfo struct {
+		URL            string `json:"url"`
+		Port           string `json:"port"`
+		HTTPSPort      string `json:"https_port"`
+		ServerProtocol string `json:"server_protocol"`
+		RTMPPort       string `json:"rtmp_port"`
+		Timezone       string `json:"timezone"`
+		TimestampNow   int64  `json:"timestamp_now"`
+		TimeNow        string `json:"time_now"`
+	} `json:"server_info"`
+}
+
+// XtreamServerStream : player_api.php?action=get_live_streams of the Threadfin Xtream Codes API, the IDs are sent as numbers like the panels do
+type XtreamServerStream struct {
+	Num               int    `json:"num"`
+	Name              string `json:"name"`
+	StreamType        string `json:"stream_type"`
+	StreamID          int    `json:"stream_id"`
+	StreamIcon        string `json:"stream_icon"`
+	EPGChannelID      string `json:"epg_channel_id"`
+	Added             string `json:"added"`
+	CategoryID        string `json:"category_id"`
+	CustomSID         string `json:"custom_sid"`
+	TVArchive         int    `json:"tv_archive"`
+	DirectSource      string `json:"direct_source"`
+	TVArchiveDuration int    `json:"tv_archive_duration"`
+}
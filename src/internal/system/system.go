@@ -153,6 +153,7 @@ func Init() (err error) {
 	// FFmpeg Default Einstellungen
 	config.System.FFmpeg.DefaultOptions = "-hide_banner -loglevel error -analyzeduration 1000000 -probesize 1000000 -i [URL] -map 0:v -map 0:a:0 -c:v copy -c:a aac -b:a 192k -ac 2 -c:s copy -f mpegts -fflags +genpts -movflags +faststart -copyts pipe:1"
 	config.System.VLC.DefaultOptions = "-I dummy [URL] --sout #std{mux=ts,access=file,dst=-}"
+	config.System.Command.DefaultOptions = "--stdout --http-header User-Agent=[USERAGENT] --http-header Referer=[REFERER] --http-proxy [PROXY] [URL] best"
 
 	// Default Logeinträge, wird später von denen aus der config.Settings.json überschrieben. Muss gemacht werden, damit die ersten Einträge auch im Log (webUI aangezeigt werden)
 	config.Settings.LogEntriesRAM = 500
@@ -180,6 +181,7 @@ func Init() (err error) {
 	config.System.Folder.Cache = config.System.Folder.Config + "cache" + string(os.PathSeparator)
 	config.System.Folder.ImagesCache = config.System.Folder.Cache + "images" + string(os.PathSeparator)
 	config.System.Folder.ImagesUpload = config.System.Folder.Data + "images" + string(os.PathSeparator)
+	config.System.Folder.Slates = config.System.Folder.Data + "slates" + string(os.PathSeparator)
 	config.System.Folder.Temp = tempFolder
 
 	// Dev Info
@@ -1,10 +1,28 @@
 package tuner
 
 import (
+	"context"
+	"fmt"
 	"strconv"
+	"sync"
 	"threadfin/internal/cli"
 	"threadfin/internal/config"
 	"threadfin/internal/provider"
+	"threadfin/internal/structs"
+	"time"
+)
+
+// probe : Background probe of a channel (package probe), it uses a connection of the provider like a stream
+type probe struct {
+	playlistID string
+	pool       string
+	cancel     context.CancelFunc
+}
+
+var (
+	probeMu sync.Mutex
+	probeID int
+	probes  = make(map[int]probe)
 )
 
 func Get(id, playlistType string) (tuner int) {
@@ -29,7 +47,15 @@ func Get(id, playlistType string) (tuner int) {
 	case "-":
 		tuner = config.Settings.Tuner
 
-	case "threadfin", "ffmpeg", "vlc":
+	case "threadfin", "ffmpeg", "vlc", "command":
+
+		// Several accounts: the sum of the connection limits of the accounts
+		if accounts := provider.GetAccounts(id, playlistType); len(accounts) > 0 {
+			for _, account := range accounts {
+				tuner += account.Tuner
+			}
+			return
+		}
 
 		i, err := strconv.Atoi(provider.GetProviderParameter(id, playlistType, "tuner"))
 		if err == nil {
@@ -43,3 +69,211 @@ func Get(id, playlistType string) (tuner int) {
 
 	return
 }
+
+// GetPool : Tuner pool of the provider (settings: tuner.pools), empty if the provider is not assigned to a pool
+func GetPool(id, playlistType string) (pool string) {
+
+	pool = provider.GetProviderParameter(id, playlistType, "tuner.pool")
+
+	if _, ok := config.Settings.TunerPools[pool]; !ok {
+		return ""
+	}
+
+	return
+}
+
+// PoolPlaylists : Active playlists (BufferInformation) of all providers in the pool
+func PoolPlaylists(pool string) (playlistIDs []string) {
+
+	config.BufferInformation.Range(func(key, value any) bool {
+		if playlist, ok := value.(structs.Playlist); ok && playlist.TunerPool == pool {
+			playlistIDs = append(playlistIDs, key.(string))
+		}
+		return true
+	})
+
+	return
+}
+
+// PoolLimitReached : All connections of the pool are used by the streams of its providers
+func PoolLimitReached(pool string) bool {
+
+	p, ok := config.Settings.TunerPools[pool]
+	if !ok {
+		return false
+	}
+
+	var streams = 0
+
+	config.BufferInformation.Range(func(_, value any) bool {
+		if playlist, ok := value.(structs.Playlist); ok && playlist.TunerPool == pool {
+			streams += len(playlist.Streams)
+		}
+		return true
+	})
+
+	streams += PoolProbes(pool)
+
+	if streams >= p.Tuner {
+		cli.ShowInfo(fmt.Sprintf("Streaming Status:Tuner pool: %s - Tuner: %d / %d", pool, streams, p.Tuner))
+		return true
+	}
+
+	return false
+}
+
+// GetAccount : Account of the provider with the most free connections for a new stream (provider: accounts).
+// The probes use the URLs of the playlist with the credentials of the first account.
+func GetAccount(playlist structs.Playlist) (account int) {
+
+	var streams = make([]int, len(playlist.Accounts))
+
+	if len(streams) > 0 {
+		streams[0] += Probes(playlist.PlaylistID)
+	}
+
+	for _, stream := range playlist.Streams {
+		if stream.Account >= 0 && stream.Account < len(streams) {
+			streams[stream.Account]++
+		}
+	}
+
+	for i := range playlist.Accounts {
+		if playlist.Accounts[i].Tuner-streams[i] > playlist.Accounts[account].Tuner-streams[account] {
+			account = i
+		}
+	}
+
+	return
+}
+
+// AddProbe : Reserves a connection of the provider for a probe, false if the tuners of the provider or its tuner pool are used.
+// cancel stops the probe when a stream needs the connection (StopProbes).
+func AddProbe(playlistID, playlistType string, cancel context.CancelFunc) (id int, ok bool) {
+
+	var pool = GetPool(playlistID, playlistType)
+	var tuner = Get(playlistID, playlistType)
+
+	probeMu.Lock()
+	defer probeMu.Unlock()
+
+	var streams, poolStreams = 0, 0
+
+	config.BufferInformation.Range(func(key, value any) bool {
+
+		if playlist, ok := value.(structs.Playlist); ok {
+
+			if key == playlistID {
+				streams += len(playlist.Streams)
+			}
+
+			if len(pool) > 0 && playlist.TunerPool == pool {
+				poolStreams += len(playlist.Streams)
+			}
+
+		}
+
+		return true
+	})
+
+	for _, p := range probes {
+
+		if p.playlistID == playlistID {
+			streams++
+		}
+
+		if len(pool) > 0 && p.pool == pool {
+			poolStreams++
+		}
+
+	}
+
+	if streams >= tuner || (len(pool) > 0 && poolStreams >= config.Settings.TunerPools[pool].Tuner) {
+		return 0, false
+	}
+
+	probeID++
+	probes[probeID] = probe{playlistID: playlistID, pool: pool, cancel: cancel}
+
+	return probeID, true
+}
+
+// RemoveProbe : The probe has ended, the connection is free again
+func RemoveProbe(id int) {
+
+	probeMu.Lock()
+	defer probeMu.Unlock()
+
+	delete(probes, id)
+
+}
+
+// Probes : Running probes of the provider
+func Probes(playlistID string) (count int) {
+
+	probeMu.Lock()
+	defer probeMu.Unlock()
+
+	for _, p := range probes {
+		if p.playlistID == playlistID {
+			count++
+		}
+	}
+
+	return
+}
+
+// PoolProbes : Running probes of all providers in the tuner pool
+func PoolProbes(pool string) (count int) {
+
+	if len(pool) == 0 {
+		return
+	}
+
+	probeMu.Lock()
+	defer probeMu.Unlock()
+
+	for _, p := range probes {
+		if p.pool == pool {
+			count++
+		}
+	}
+
+	return
+}
+
+// StopProbes : A stream of the provider needs a connection, the probes of the provider and its tuner pool are canceled.
+// Waits until the probes have closed their connections (max. 5 seconds).
+func StopProbes(playlistID, pool string) {
+
+	var stop = func(p probe) bool {
+		return p.playlistID == playlistID || (len(pool) > 0 && p.pool == pool)
+	}
+
+	var running = func() (count int) {
+
+		probeMu.Lock()
+		defer probeMu.Unlock()
+
+		for _, p := range probes {
+			if stop(p) {
+				p.cancel()
+				count++
+			}
+		}
+
+		return
+	}
+
+	var count = running()
+	if count == 0 {
+		return
+	}
+
+	cli.ShowInfo(fmt.Sprintf("Streaming Status:Playlist: %s - Probes canceled: %d", playlistID, count))
+
+	for i := 0; i < 50 && running() > 0; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+
+}
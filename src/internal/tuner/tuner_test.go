@@ -0,0 +1,159 @@
+package tuner
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/provider"
+	"threadfin/internal/structs"
+)
+
+func TestTunerPool(t *testing.T) {
+
+	config.Settings.TunerPools = map[string]structs.TunerPool{"account": {Name: "account", Tuner: 3}}
+	config.Settings.Files.M3U = map[string]any{
+		"M1": map[string]any{"tuner.pool": "account"},
+		"M2": map[string]any{"tuner.pool": "-"},
+		"M3": map[string]any{"tuner.pool": "deleted"},
+	}
+	defer func() {
+		config.Settings.TunerPools = nil
+		config.Settings.Files.M3U = nil
+	}()
+
+	if pool := GetPool("M1", "m3u"); pool != "account" {
+		t.Errorf("unexpected pool: %s", pool)
+	}
+
+	for _, id := range []string{"M2", "M3", "M4"} {
+		if pool := GetPool(id, "m3u"); pool != "" {
+			t.Errorf("%s: unexpected pool: %s", id, pool)
+		}
+	}
+
+	var playlists = []structs.Playlist{
+		{PlaylistID: "M1", TunerPool: "account", Streams: map[int]structs.ThisStream{0: {}, 1: {}}},
+		{PlaylistID: "M5", TunerPool: "account", Streams: map[int]structs.ThisStream{0: {}}},
+		{PlaylistID: "M2", Streams: map[int]structs.ThisStream{0: {}, 1: {}}},
+	}
+
+	for _, playlist := range playlists[:2] {
+		config.BufferInformation.Store(playlist.PlaylistID, playlist)
+		defer config.BufferInformation.Delete(playlist.PlaylistID)
+	}
+
+	if !PoolLimitReached("account") {
+		t.Error("3 streams in a pool with 3 tuners")
+	}
+
+	if ids := PoolPlaylists("account"); len(ids) != 2 || !slices.Contains(ids, "M1") || !slices.Contains(ids, "M5") {
+		t.Errorf("unexpected playlists: %v", ids)
+	}
+
+	// Streams of providers without the pool are not counted
+	config.BufferInformation.Delete("M5")
+	config.BufferInformation.Store("M2", playlists[2])
+	defer config.BufferInformation.Delete("M2")
+
+	if PoolLimitReached("account") {
+		t.Error("2 streams in a pool with 3 tuners")
+	}
+
+	if PoolLimitReached("") {
+		t.Error("no pool")
+	}
+
+}
+
+func TestAccounts(t *testing.T) {
+
+	config.Settings.Files.M3U = map[string]any{
+		"M1": map[string]any{"buffer": "threadfin", "tuner": "1", "accounts": "alice:secret:2 | bob:p:a:ss:3 | :token"},
+	}
+	defer func() { config.Settings.Files.M3U = nil }()
+
+	var playlist = structs.Playlist{Accounts: provider.GetAccounts("M1", "m3u"), Streams: map[int]structs.ThisStream{}}
+
+	var expected = []structs.ProviderAccount{{Username: "alice", Password: "secret", Tuner: 2}, {Username: "bob", Password: "p:a:ss", Tuner: 3}, {Password: "token", Tuner: 1}}
+	if !slices.Equal(playlist.Accounts, expected) {
+		t.Fatalf("unexpected accounts: %+v", playlist.Accounts)
+	}
+
+	// Sum of the connections of all accounts
+	if tuner := Get("M1", "m3u"); tuner != 6 {
+		t.Errorf("expected 6 tuners, got %d", tuner)
+	}
+
+	// The account with the most free connections is used
+	for i, account := range []int{1, 0, 1, 0, 1, 2} {
+
+		if a := GetAccount(playlist); a != account {
+			t.Errorf("stream %d: expected account %d, got %d", i, account, a)
+		}
+
+		playlist.Streams[i] = structs.ThisStream{Account: account}
+	}
+
+}
+
+func TestProbes(t *testing.T) {
+
+	config.Settings.TunerPools = map[string]structs.TunerPool{"account": {Name: "account", Tuner: 3}}
+	config.Settings.Files.M3U = map[string]any{
+		"M1": map[string]any{"buffer": "threadfin", "tuner": float64(2), "tuner.pool": "account"},
+		"M2": map[string]any{"buffer": "threadfin", "tuner": float64(5), "tuner.pool": "account", "accounts": "alice:secret:2 | bob:secret:3"},
+	}
+	config.BufferInformation.Store("M1", structs.Playlist{PlaylistID: "M1", TunerPool: "account", Streams: map[int]structs.ThisStream{0: {}}})
+	defer func() {
+		config.Settings.TunerPools = nil
+		config.Settings.Files.M3U = nil
+		config.BufferInformation.Delete("M1")
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// 1 stream and 2 tuners
+	probe1, ok := AddProbe("M1", "m3u", cancel)
+	if !ok {
+		t.Fatal("M1: free tuner")
+	}
+
+	if _, ok = AddProbe("M1", "m3u", cancel); ok {
+		t.Error("M1: all tuners are used by the stream and the probe")
+	}
+
+	// The pool has 3 tuners: 1 stream, 1 probe of M1
+	probe2, ok := AddProbe("M2", "m3u", func() {})
+	if !ok {
+		t.Fatal("M2: free tuner in the pool")
+	}
+
+	if _, ok = AddProbe("M2", "m3u", func() {}); ok {
+		t.Error("M2: all tuners of the pool are used")
+	}
+
+	// The probes are counted for the streams of the pool and the first account of the provider
+	if !PoolLimitReached("account") {
+		t.Error("1 stream and 2 probes in a pool with 3 tuners")
+	}
+
+	if account := GetAccount(structs.Playlist{PlaylistID: "M2", Accounts: provider.GetAccounts("M2", "m3u"), Streams: map[int]structs.ThisStream{0: {Account: 1}}}); account != 1 {
+		t.Errorf("expected account 2, got %d", account+1)
+	}
+
+	RemoveProbe(probe2)
+
+	// A stream of M1 cancels the probe of M1
+	go func() {
+		<-ctx.Done()
+		RemoveProbe(probe1)
+	}()
+
+	StopProbes("M1", "account")
+
+	if ctx.Err() == nil || Probes("M1") != 0 || PoolProbes("account") != 0 {
+		t.Error("the probe of M1 is still running")
+	}
+
+}
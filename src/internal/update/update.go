@@ -263,6 +263,27 @@ checkVersion:
 		case "2.1.0":
 			// Falls es in einem späteren Update Änderungen an der Datenbank gibt, geht es hier weiter
 
+			break
+
+		case "0.5.0":
+			// Backup channels 1 - 3 are converted into an ordered list
+			err = convertBackupChannels()
+			if err != nil {
+				return
+			}
+
+			settingsMap["version"] = "0.6.0"
+
+			err = storage.SaveMapToJSONFile(config.System.File.Settings, settingsMap)
+			if err != nil {
+				return
+			}
+
+			goto checkVersion
+
+		case "0.6.0":
+			// Falls es in einem späteren Update Änderungen an der Datenbank gibt, geht es hier weiter
+
 			break
 		}
 
@@ -327,3 +348,44 @@ func setValueForUUID() (err error) {
 
 	return
 }
+
+// convertBackupChannels : Converts the backup channels (x-backup-channel-1 - 3) of the XEPG channels into the list x-backup-channels
+func convertBackupChannels() (err error) {
+
+	xepg, _ := storage.LoadJSONFileToMap(config.System.File.XEPG)
+	if len(xepg) == 0 {
+		return
+	}
+
+	for _, c := range xepg {
+
+		var xepgChannel, ok = c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var backupChannels = []string{}
+
+		for i := 1; i <= 3; i++ {
+
+			var key = fmt.Sprintf("x-backup-channel-%d", i)
+
+			if value, ok := xepgChannel[key].(string); ok && len(value) > 0 && value != "-" {
+				backupChannels = append(backupChannels, value)
+			}
+
+			delete(xepgChannel, key)
+			delete(xepgChannel, fmt.Sprintf("backup_channel_%d", i))
+
+		}
+
+		if _, ok := xepgChannel["x-backup-channels"]; !ok {
+			xepgChannel["x-backup-channels"] = backupChannels
+		}
+
+	}
+
+	err = storage.SaveMapToJSONFile(config.System.File.XEPG, xepg)
+
+	return
+}
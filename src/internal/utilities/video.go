@@ -0,0 +1,38 @@
+package utilities
+
+import (
+	"strconv"
+	"strings"
+)
+
+// VideoQuality : XMLTV video quality of a resolution (e.g. 1080p: HDTV), empty if the resolution is unknown
+func VideoQuality(resolution string) string {
+
+	var height = VideoHeight(resolution)
+	if height <= 0 {
+		return ""
+	}
+
+	switch {
+
+	case height >= 2160:
+		return "UHDTV"
+
+	case height >= 720:
+		return "HDTV"
+
+	}
+
+	return "SDTV"
+}
+
+// VideoHeight : Height of a resolution (e.g. 1080p: 1080), 0 if the resolution is unknown
+func VideoHeight(resolution string) int {
+
+	height, err := strconv.Atoi(strings.TrimSuffix(resolution, "p"))
+	if err != nil || height < 0 {
+		return 0
+	}
+
+	return height
+}
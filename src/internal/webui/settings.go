@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strings"
 	"threadfin/internal/cli"
@@ -41,7 +42,7 @@ func UpdateServerSettings(request structs.RequestStruct) (settings structs.Setti
 			case "tuner":
 				cli.ShowWarning(2105)
 
-			case "epgSource":
+			case "epgSource", "channel.groups", "channel.groups.rules":
 				reloadData = true
 
 			case "update":
@@ -68,7 +69,7 @@ func UpdateServerSettings(request structs.RequestStruct) (settings structs.Setti
 				cacheImages = true
 
 			case "xepg.replace.missing.images":
-			case "xepg.replace.channel.title":
+			case "xepg.replace.channel.title", "m3u.attributes.allow", "m3u.attributes.deny":
 				createXEPGFiles = true
 
 			case "backup.path":
@@ -103,7 +104,7 @@ func UpdateServerSettings(request structs.RequestStruct) (settings structs.Setti
 					return
 				}
 
-			case "ffmpeg.path", "vlc.path":
+			case "ffmpeg.path", "vlc.path", "command.path":
 				var path = value.(string)
 				if len(path) > 0 {
 
@@ -114,6 +115,54 @@ func UpdateServerSettings(request structs.RequestStruct) (settings structs.Setti
 
 				}
 
+			case "buffer.profiles":
+				// The commands of the profiles are checked like the FFmpeg / VLC path
+				for _, p := range value.(map[string]any) {
+
+					if profile, ok := p.(map[string]any); ok {
+
+						if path, ok := profile["path"].(string); ok && len(path) > 0 {
+
+							err = storage.CheckFile(path)
+							if err != nil {
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case "stream.priorities":
+				// IP ranges are checked before the rules are used by the tuner preemption
+				for _, r := range value.([]any) {
+
+					if rule, ok := r.(map[string]any); ok {
+
+						if ip, ok := rule["ip"].(string); ok && len(ip) > 0 && net.ParseIP(ip) == nil {
+
+							_, _, err = net.ParseCIDR(ip)
+							if err != nil {
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case "udp.interface":
+				if name := value.(string); len(name) > 0 {
+
+					_, err = net.InterfaceByName(name)
+					if err != nil {
+						return
+					}
+
+				}
+
 			case "scheme.m3u", "scheme.xml":
 				createXEPGFiles = true
 
@@ -158,6 +207,7 @@ func UpdateServerSettings(request structs.RequestStruct) (settings structs.Setti
 		config.Settings.AuthenticationPMS = false
 		config.Settings.AuthenticationWEB = false
 		config.Settings.AuthenticationXML = false
+		config.Settings.AuthenticationXtream = false
 
 	}
 
@@ -170,6 +220,10 @@ func UpdateServerSettings(request structs.RequestStruct) (settings structs.Setti
 		config.Settings.VLCOptions = config.System.VLC.DefaultOptions
 	}
 
+	if len(config.Settings.CommandOptions) == 0 {
+		config.Settings.CommandOptions = config.System.Command.DefaultOptions
+	}
+
 	switch config.Settings.Buffer {
 
 	case "ffmpeg":
@@ -186,6 +240,13 @@ func UpdateServerSettings(request structs.RequestStruct) (settings structs.Setti
 			return
 		}
 
+	case "command":
+
+		if len(config.Settings.CommandPath) == 0 {
+			err = errors.New(cli.GetErrMsg(2022))
+			return
+		}
+
 	}
 
 	err = systemSettings.SaveSettings(config.Settings)
@@ -95,7 +95,9 @@ func Save(request structs.RequestStruct) (nextStep int, err error) {
 				}
 
 				xepg.BuildXEPG(false)
+				config.XepgMutex.Lock()
 				config.System.ScanInProgress = 0
+				config.XepgMutex.Unlock()
 
 			}
 
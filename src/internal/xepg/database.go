@@ -1,6 +1,7 @@
 package xepg
 
 import (
+	"bytes"
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
@@ -13,6 +14,7 @@ import (
 	"threadfin/internal/cli"
 	"threadfin/internal/config"
 	jsonserializer "threadfin/internal/json-serializer"
+	m3uparser "threadfin/internal/m3u-parser"
 	"threadfin/internal/storage"
 	"threadfin/internal/structs"
 	"threadfin/internal/utilities"
@@ -191,12 +193,15 @@ func createXEPGDatabase() (err error) {
 		var currentXEPGID string   // Aktuelle Datenbank ID (XEPG). Wird verwendet, um den Kanal in der Datenbank mit dem Stream der M3u zu aktualisieren. Current database ID (XEPG) Used to update the channel in the database with the stream of the M3u
 
 		var m3uChannel structs.M3UChannelStructXEPG
+		var streamJSON = []byte(jsonserializer.MapToJSON(dsa))
 
-		err = json.Unmarshal([]byte(jsonserializer.MapToJSON(dsa)), &m3uChannel)
+		err = json.Unmarshal(streamJSON, &m3uChannel)
 		if err != nil {
 			return
 		}
 
+		var attributes, directives = providerAttributes(streamJSON)
+
 		if m3uChannel.TvgName == "" {
 			m3uChannel.TvgName = m3uChannel.Name
 		}
@@ -276,6 +281,10 @@ func createXEPGDatabase() (err error) {
 			// Always update streaming URL
 			xepgChannel.URL = m3uChannel.URL
 
+			// The attributes and directives of the provider are updated like the URL
+			xepgChannel.Attributes = attributes
+			xepgChannel.Directives = directives
+
 			// Update Live Event status
 			if m3uChannel.LiveEvent == "true" {
 				xepgChannel.Live = true
@@ -361,6 +370,8 @@ func createXEPGDatabase() (err error) {
 			newChannel.TvgLogo = m3uChannel.TvgLogo
 			newChannel.TvgName = m3uChannel.TvgName
 			newChannel.URL = m3uChannel.URL
+			newChannel.Attributes = attributes
+			newChannel.Directives = directives
 			newChannel.Live, _ = strconv.ParseBool(m3uChannel.LiveEvent)
 
 			for file, xmltvChannels := range config.Data.XMLTV.Mapping {
@@ -459,6 +470,46 @@ func createXEPGDatabase() (err error) {
 	return
 }
 
+// providerAttributes : Attributes and directives of the provider of a stream (M3U parser).
+// Numbers and booleans are used as text, values that are not text (objects, lists) are skipped.
+func providerAttributes(streamJSON []byte) (attributes map[string]string, directives []string) {
+
+	var values map[string]any
+
+	var decoder = json.NewDecoder(bytes.NewReader(streamJSON))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&values); err != nil {
+		return
+	}
+
+	var stream = make(map[string]string, len(values))
+
+	for key, value := range values {
+
+		switch value := value.(type) {
+
+		case string:
+			stream[key] = value
+
+		case json.Number, bool:
+			stream[key] = fmt.Sprint(value)
+
+		}
+
+	}
+
+	attributes = m3uparser.Attributes(stream)
+
+	for name := range attributes {
+		if _, ok := stream[name]; !ok {
+			delete(attributes, name)
+		}
+	}
+
+	return attributes, m3uparser.Directives(stream)
+}
+
 func isInInactiveList(channelURL string) bool {
 	for _, channel := range config.Data.Streams.Inactive {
 		// Type assert channel to map[string]interface{}
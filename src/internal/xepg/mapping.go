@@ -7,11 +7,13 @@ import (
 	"log"
 	"path"
 	"strings"
+	"threadfin/internal/channels"
 	"threadfin/internal/cli"
 	"threadfin/internal/config"
 	"threadfin/internal/imgcache"
 	jsonserializer "threadfin/internal/json-serializer"
 	"threadfin/internal/m3u"
+	"threadfin/internal/probe"
 	"threadfin/internal/provider"
 	"threadfin/internal/storage"
 	"threadfin/internal/structs"
@@ -126,6 +128,8 @@ func createXEPGMapping() {
 func mapping() (err error) {
 	cli.ShowInfo("XEPG:" + "Map channels")
 
+	var backups *backupIndex
+
 	for xepg, dxc := range config.Data.XEPG.Channels {
 
 		var xepgChannel structs.XEPGChannelStruct
@@ -138,36 +142,15 @@ func mapping() (err error) {
 			xepgChannel.TvgName = xepgChannel.Name
 		}
 
-		if (xepgChannel.XBackupChannel1 != "" && xepgChannel.XBackupChannel1 != "-") || (xepgChannel.XBackupChannel2 != "" && xepgChannel.XBackupChannel2 != "-") || (xepgChannel.XBackupChannel3 != "" && xepgChannel.XBackupChannel3 != "-") {
-			for _, stream := range config.Data.Streams.Active {
-				var m3uChannel structs.M3UChannelStructXEPG
-
-				err = json.Unmarshal([]byte(jsonserializer.MapToJSON(stream)), &m3uChannel)
-				if err != nil {
-					return err
-				}
-
-				if m3uChannel.TvgName == "" {
-					m3uChannel.TvgName = m3uChannel.Name
-				}
-
-				backup_channel1 := strings.Trim(xepgChannel.XBackupChannel1, " ")
-				if m3uChannel.TvgName == backup_channel1 {
-					xepgChannel.BackupChannel1 = &structs.BackupStream{PlaylistID: m3uChannel.FileM3UID, URL: m3uChannel.URL}
-				}
-
-				backup_channel2 := strings.Trim(xepgChannel.XBackupChannel2, " ")
-				if m3uChannel.TvgName == backup_channel2 {
-					xepgChannel.BackupChannel2 = &structs.BackupStream{PlaylistID: m3uChannel.FileM3UID, URL: m3uChannel.URL}
-				}
-
-				backup_channel3 := strings.Trim(xepgChannel.XBackupChannel3, " ")
-				if m3uChannel.TvgName == backup_channel3 {
-					xepgChannel.BackupChannel3 = &structs.BackupStream{PlaylistID: m3uChannel.FileM3UID, URL: m3uChannel.URL}
-				}
+		if len(xepgChannel.XBackupChannels) > 0 && backups == nil {
+			backups, err = newBackupIndex()
+			if err != nil {
+				return err
 			}
 		}
 
+		xepgChannel.BackupChannels = backups.find(xepgChannel.XBackupChannels)
+
 		// Automatische Mapping für neue Kanäle. Wird nur ausgeführt, wenn der Kanal deaktiviert ist und keine XMLTV Datei und kein XMLTV Kanal zugeordnet ist.
 		if !xepgChannel.XActive {
 			// Werte kann "-" sein, deswegen len < 1
@@ -309,6 +292,9 @@ func mapping() (err error) {
 
 	}
 
+	// Duplicate channels: primary channel and backup streams (settings: channel.groups)
+	channels.Group(probe.Availability())
+
 	err = storage.SaveMapToJSONFile(config.System.File.XEPG, config.Data.XEPG.Channels)
 	if err != nil {
 		cli.ShowError(err, 000)
@@ -335,11 +321,20 @@ func SaveXEpgMapping(request structs.RequestStruct) (err error) {
 		return
 	}
 
+	// Channels that have been activated by the user are no longer activated again by the probes (x-probe-deactivated)
+	for _, dxc := range request.EpgMapping {
+		if channel, ok := dxc.(map[string]any); ok && channel["x-active"] == true {
+			delete(channel, "x-probe-deactivated")
+		}
+	}
+
 	err = storage.SaveMapToJSONFile(config.System.File.XEPG, request.EpgMapping)
 	if err != nil {
 		return err
 	}
 
+	// The background probes change the XEPG channels with config.XepgMutex
+	config.XepgMutex.Lock()
 	config.Data.XEPG.Channels = request.EpgMapping
 
 	if config.System.ScanInProgress == 0 {
@@ -351,10 +346,13 @@ func SaveXEpgMapping(request structs.RequestStruct) (err error) {
 		}
 		m3u.CreateFile()
 		config.System.ScanInProgress = 0
+		config.XepgMutex.Unlock()
 		cli.ShowInfo("XEPG: Ready to use")
 
 	} else {
 
+		config.XepgMutex.Unlock()
+
 		// Wenn während des erstellen der Datanbank das Mapping erneut gespeichert wird, wird die Datenbank erst später erneut aktualisiert.
 		go func() {
 
@@ -364,11 +362,14 @@ func SaveXEpgMapping(request structs.RequestStruct) (err error) {
 
 			config.System.BackgroundProcess = true
 
+			// The files are created with config.XepgMutex, like ScanInProgress is set by the XEPG builds
 			for {
 				time.Sleep(time.Duration(1) * time.Second)
+				config.XepgMutex.Lock()
 				if config.System.ScanInProgress == 0 {
 					break
 				}
+				config.XepgMutex.Unlock()
 
 			}
 
@@ -379,6 +380,7 @@ func SaveXEpgMapping(request structs.RequestStruct) (err error) {
 			}
 			m3u.CreateFile()
 			config.System.ScanInProgress = 0
+			config.XepgMutex.Unlock()
 			cli.ShowInfo("XEPG: Ready to use")
 
 			config.System.BackgroundProcess = false
@@ -389,3 +391,78 @@ func SaveXEpgMapping(request structs.RequestStruct) (err error) {
 
 	return
 }
+
+// backupIndex : Streams that can be used as backup channel, by XEPG ID, tvg-id and name
+type backupIndex struct {
+	xepg  map[string]structs.BackupStream
+	tvgID map[string]structs.BackupStream
+	name  map[string]structs.BackupStream
+}
+
+// newBackupIndex : Index of the XEPG channels and of all active streams. If several streams have the same tvg-id or name, the first one is used.
+func newBackupIndex() (index *backupIndex, err error) {
+
+	index = &backupIndex{
+		xepg:  make(map[string]structs.BackupStream),
+		tvgID: make(map[string]structs.BackupStream),
+		name:  make(map[string]structs.BackupStream),
+	}
+
+	var add = func(m map[string]structs.BackupStream, key string, backup structs.BackupStream) {
+		if _, ok := m[key]; !ok && len(key) > 0 {
+			m[key] = backup
+		}
+	}
+
+	for xepg, dxc := range config.Data.XEPG.Channels {
+
+		var xepgChannel structs.XEPGChannelStruct
+		err = json.Unmarshal([]byte(jsonserializer.MapToJSON(dxc)), &xepgChannel)
+		if err != nil {
+			return
+		}
+
+		index.xepg[xepg] = structs.BackupStream{PlaylistID: xepgChannel.FileM3UID, URL: xepgChannel.URL}
+
+	}
+
+	for _, stream := range config.Data.Streams.Active {
+
+		var m3uChannel structs.M3UChannelStructXEPG
+		err = json.Unmarshal([]byte(jsonserializer.MapToJSON(stream)), &m3uChannel)
+		if err != nil {
+			return
+		}
+
+		var backup = structs.BackupStream{PlaylistID: m3uChannel.FileM3UID, URL: m3uChannel.URL}
+
+		add(index.tvgID, m3uChannel.TvgID, backup)
+		add(index.name, m3uChannel.TvgName, backup)
+		add(index.name, m3uChannel.Name, backup)
+
+	}
+
+	return
+}
+
+// find : Backup streams in the order of the list. An entry is an XEPG ID, a tvg-id or a channel name, entries without a stream are skipped.
+func (index *backupIndex) find(entries []string) (backups []structs.BackupStream) {
+
+	for _, entry := range entries {
+
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 || entry == "-" || index == nil {
+			continue
+		}
+
+		for _, m := range []map[string]structs.BackupStream{index.xepg, index.tvgID, index.name} {
+			if backup, ok := m[entry]; ok {
+				backups = append(backups, backup)
+				break
+			}
+		}
+
+	}
+
+	return
+}
@@ -0,0 +1,62 @@
+package xepg
+
+import (
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/structs"
+)
+
+func TestBackupIndexFind(t *testing.T) {
+
+	config.Data.XEPG.Channels = map[string]interface{}{
+		"x-ID.1": map[string]interface{}{"_file.m3u.id": "M1", "url": "http://a/1"},
+	}
+
+	config.Data.Streams.Active = []interface{}{
+		map[string]interface{}{"_file.m3u.id": "M2", "url": "http://b/1", "tvg-id": "one.de", "tvg-name": "One", "name": "One HD"},
+		map[string]interface{}{"_file.m3u.id": "M2", "url": "http://b/2", "tvg-id": "two.de", "tvg-name": "Two", "name": "Two"},
+		map[string]interface{}{"_file.m3u.id": "M3", "url": "http://c/1", "tvg-id": "one.de", "tvg-name": "One", "name": "One"},
+	}
+
+	index, err := newBackupIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var backups = index.find([]string{"two.de", "-", "unknown", " x-ID.1 ", "One HD", "One"})
+
+	var expected = []structs.BackupStream{
+		{PlaylistID: "M2", URL: "http://b/2"},
+		{PlaylistID: "M1", URL: "http://a/1"},
+		{PlaylistID: "M2", URL: "http://b/1"},
+		{PlaylistID: "M2", URL: "http://b/1"},
+	}
+
+	if len(backups) != len(expected) {
+		t.Fatalf("expected %d backup streams, got %d: %+v", len(expected), len(backups), backups)
+	}
+
+	for i := range expected {
+		if backups[i] != expected[i] {
+			t.Errorf("backup %d: expected %+v, got %+v", i, expected[i], backups[i])
+		}
+	}
+
+}
+
+func TestProviderAttributes(t *testing.T) {
+
+	// Stream of a provider with values that are not text: only the list is skipped
+	var streamJSON = []byte(`{"name": "One", "_attributes": "tvg-shift catchup-days tvg-rec x-list", "tvg-shift": 2.5, "catchup-days": "7", "tvg-rec": true, "x-list": [1], "_directives": "#EXTGRP:News"}`)
+
+	attributes, directives := providerAttributes(streamJSON)
+
+	if _, ok := attributes["x-list"]; len(attributes) != 3 || attributes["tvg-shift"] != "2.5" || attributes["catchup-days"] != "7" || attributes["tvg-rec"] != "true" || ok {
+		t.Errorf("unexpected attributes: %v", attributes)
+	}
+
+	if len(directives) != 1 || directives[0] != "#EXTGRP:News" {
+		t.Errorf("unexpected directives: %q", directives)
+	}
+
+}
@@ -106,10 +106,12 @@ func BuildXEPG(background bool) {
 
 				}
 
-				// Core work is done; exit maintenance
+				// Core work is done; exit maintenance. The readers of the XEPG channels check ScanInProgress with config.XepgMutex
+				config.XepgMutex.Lock()
 				config.SystemMutex.Lock()
 				config.System.ScanInProgress = 0
 				config.SystemMutex.Unlock()
+				config.XepgMutex.Unlock()
 
 				// Cache löschen
 				config.Data.Cache.XMLTV = make(map[string]structs.XMLTV)
@@ -200,6 +202,10 @@ func BuildXEPG(background bool) {
 
 // Update XEPG data
 func UpdateXEPG(background bool) {
+	config.XepgMutex.Lock()
+	defer func() {
+		config.XepgMutex.Unlock()
+	}()
 
 	if config.System.ScanInProgress == 1 {
 		return
@@ -3,6 +3,7 @@ package xmltv
 import (
 	"strings"
 	"threadfin/internal/structs"
+	"threadfin/internal/utilities"
 )
 
 // Videoparameter erstellen (createXMLTVFile)
@@ -26,5 +27,10 @@ func getVideo(program *structs.Program, xmltvProgram *structs.Program, xepgChann
 
 	}
 
+	// Quality of the stream (probe)
+	if quality := utilities.VideoQuality(xepgChannel.XResolution); len(quality) > 0 {
+		video.Quality = quality
+	}
+
 	program.Video = video
 }
@@ -0,0 +1,429 @@
+package xtream
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"threadfin/internal/config"
+	jsonserializer "threadfin/internal/json-serializer"
+	"threadfin/internal/playlist"
+	"threadfin/internal/structs"
+	"threadfin/internal/xmltv"
+	"time"
+)
+
+// shortEPGLimit : Programs of get_short_epg without limit, the panels use the same default
+const shortEPGLimit = 4
+
+// LiveStream : Active XEPG channel of the Xtream Codes API of Threadfin
+type LiveStream struct {
+	StreamID   int    // Number of the XEPG ID (x-ID.<StreamID>)
+	CategoryID string // Position of the group in the categories
+	Group      string
+	Channel    structs.XEPGChannelStruct
+}
+
+// lineup : Last lineup that has been read without a running XEPG build, locked with config.XepgMutex
+var lineup struct {
+	streams    []LiveStream
+	categories []structs.XtreamCategory
+}
+
+// scanInProgress : The XEPG build in the background changes the XEPG channels without config.XepgMutex, the last lineup is used.
+// config.XepgMutex is locked by the caller.
+func scanInProgress() bool {
+	return config.System.ScanInProgress == 1
+}
+
+// Lineup : Active XEPG channels ordered by the channel number and the groups of the channels as categories.
+// The group is the same as in the M3U file (x-category, x-group-title, group-title).
+func Lineup() (streams []LiveStream, categories []structs.XtreamCategory) {
+
+	var groups = make(map[string]string)
+
+	config.XepgMutex.Lock()
+	defer config.XepgMutex.Unlock()
+
+	if scanInProgress() {
+		return slices.Clone(lineup.streams), slices.Clone(lineup.categories)
+	}
+
+	for id, dxc := range config.Data.XEPG.Channels {
+
+		if stream, ok := liveStream(id, dxc); ok {
+			groups[stream.Group] = ""
+			streams = append(streams, stream)
+		}
+
+	}
+
+	var names = make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for i, name := range names {
+		groups[name] = strconv.Itoa(i + 1)
+		categories = append(categories, structs.XtreamCategory{CategoryID: structs.XtreamValue(groups[name]), CategoryName: name, ParentID: "0"})
+	}
+
+	for i := range streams {
+		streams[i].CategoryID = groups[streams[i].Group]
+	}
+
+	sort.Slice(streams, func(i, j int) bool {
+
+		numI, errI := strconv.ParseFloat(streams[i].Channel.XChannelID, 64)
+		numJ, errJ := strconv.ParseFloat(streams[j].Channel.XChannelID, 64)
+
+		if errI == nil && errJ == nil && numI != numJ {
+			return numI < numJ
+		}
+
+		if (errI == nil) != (errJ == nil) {
+			return errI == nil
+		}
+
+		return streams[i].StreamID < streams[j].StreamID
+	})
+
+	lineup.streams, lineup.categories = slices.Clone(streams), slices.Clone(categories)
+
+	return
+}
+
+// FindStream : Active XEPG channel of a stream ID, the XEPG channel is read directly (CategoryID is not set).
+// During an XEPG build the stream is taken from the last lineup.
+func FindStream(streamID int) (stream LiveStream, ok bool) {
+
+	var id = "x-ID." + strconv.Itoa(streamID)
+
+	config.XepgMutex.Lock()
+	defer config.XepgMutex.Unlock()
+
+	if scanInProgress() {
+
+		if i := slices.IndexFunc(lineup.streams, func(s LiveStream) bool { return s.StreamID == streamID }); i >= 0 {
+			return lineup.streams[i], true
+		}
+
+		return
+	}
+
+	dxc, ok := config.Data.XEPG.Channels[id]
+	if !ok {
+		return
+	}
+
+	return liveStream(id, dxc)
+}
+
+// liveStream : Stream of an XEPG channel, ok is false for inactive and hidden channels
+func liveStream(id string, dxc any) (stream LiveStream, ok bool) {
+
+	var channel structs.XEPGChannelStruct
+	if err := json.Unmarshal([]byte(jsonserializer.MapToJSON(dxc)), &channel); err != nil {
+		return
+	}
+
+	if !channel.XActive || channel.XHideChannel {
+		return
+	}
+
+	streamID, err := strconv.Atoi(strings.TrimPrefix(id, "x-ID."))
+	if err != nil {
+		return
+	}
+
+	if len(channel.XName) == 0 {
+		channel.XName = channel.Name
+	}
+
+	stream = LiveStream{StreamID: streamID, Group: channel.XCategory, Channel: channel}
+
+	if len(stream.Group) == 0 {
+		stream.Group = channel.XGroupTitle
+	}
+
+	if len(stream.Group) == 0 {
+		stream.Group = channel.GroupTitle
+	}
+
+	return stream, true
+}
+
+// Account : player_api.php without action. Apps show the login as failed if auth is 0.
+func Account(username, password string, auth bool) (account structs.XtreamServerAccount) {
+
+	account.UserInfo.Username = username
+	account.UserInfo.Password = password
+
+	if !auth {
+		return
+	}
+
+	var host, port = serverHost()
+	var now = time.Now()
+
+	account.UserInfo.Auth = 1
+	account.UserInfo.Status = "Active"
+	account.UserInfo.Message = config.System.Name
+	account.UserInfo.IsTrial = "0"
+	account.UserInfo.ActiveConnections = strconv.Itoa(playlist.GetActiveCount())
+	account.UserInfo.CreatedAt = strconv.FormatInt(now.Unix(), 10)
+	account.UserInfo.MaxConnections = strconv.Itoa(config.Settings.Tuner)
+	account.UserInfo.AllowedOutputFormats = []string{"ts", "m3u8"}
+
+	account.ServerInfo.URL = host
+	account.ServerInfo.Port = port
+	account.ServerInfo.HTTPSPort = strconv.Itoa(config.Settings.HttpsPort)
+	account.ServerInfo.ServerProtocol = serverProtocol()
+	account.ServerInfo.Timezone = now.Location().String()
+	account.ServerInfo.TimestampNow = now.Unix()
+	account.ServerInfo.TimeNow = now.Format("2006-01-02 15:04:05")
+
+	return
+}
+
+// PlayerAPI : Response of player_api.php for the action of the query. VOD and series are not available, the lists are empty.
+func PlayerAPI(query url.Values) (response any, err error) {
+
+	var streams, categories = Lineup()
+
+	switch action := query.Get("action"); action {
+
+	case "":
+		response = Account(query.Get("username"), query.Get("password"), true)
+
+	case "get_live_categories":
+		response = append([]structs.XtreamCategory{}, categories...)
+
+	case "get_live_streams":
+		response = LiveStreams(streams, query.Get("category_id"))
+
+	case "get_short_epg", "get_simple_data_table":
+		var streamID int
+		streamID, err = strconv.Atoi(query.Get("stream_id"))
+		if err != nil {
+			err = fmt.Errorf("xtream: invalid stream_id %q", query.Get("stream_id"))
+			return
+		}
+
+		var listings = []structs.XtreamEPGListing{}
+
+		for _, stream := range streams {
+
+			if stream.StreamID != streamID {
+				continue
+			}
+
+			if action == "get_short_epg" {
+				limit, _ := strconv.Atoi(query.Get("limit"))
+				listings = EPGListings(stream.Channel, limit, false)
+			} else {
+				listings = EPGListings(stream.Channel, 0, true)
+			}
+
+		}
+
+		response = map[string][]structs.XtreamEPGListing{"epg_listings": listings}
+
+	case "get_vod_categories", "get_vod_streams", "get_series_categories", "get_series":
+		response = []any{}
+
+	default:
+		err = fmt.Errorf("xtream: unsupported action %s", action)
+
+	}
+
+	return
+}
+
+// LiveStreams : get_live_streams, all streams or the streams of a category
+func LiveStreams(streams []LiveStream, categoryID string) (liveStreams []structs.XtreamServerStream) {
+
+	liveStreams = []structs.XtreamServerStream{}
+
+	for i, stream := range streams {
+
+		if len(categoryID) > 0 && stream.CategoryID != categoryID {
+			continue
+		}
+
+		liveStreams = append(liveStreams, structs.XtreamServerStream{
+			Num:          i + 1,
+			Name:         stream.Channel.XName,
+			StreamType:   "live",
+			StreamID:     stream.StreamID,
+			StreamIcon:   logo(stream.Channel.TvgLogo),
+			EPGChannelID: stream.Channel.XChannelID,
+			Added:        "0",
+			CategoryID:   stream.CategoryID,
+		})
+
+	}
+
+	return
+}
+
+// EPGListings : Programs of the XMLTV file of Threadfin. Short EPG: the current and next programs (limit), otherwise all programs with now_playing.
+func EPGListings(channel structs.XEPGChannelStruct, limit int, all bool) (listings []structs.XtreamEPGListing) {
+
+	listings = []structs.XtreamEPGListing{}
+
+	if limit <= 0 {
+		limit = shortEPGLimit
+	}
+
+	guide, err := xmltv.GetData(channel)
+	if err != nil {
+		return
+	}
+
+	sort.SliceStable(guide.Program, func(i, j int) bool {
+		return guide.Program[i].Start < guide.Program[j].Start
+	})
+
+	var now = time.Now()
+
+	for i, program := range guide.Program {
+
+		start, errStart := time.Parse(timeLayout, program.Start)
+		stop, errStop := time.Parse(timeLayout, program.Stop)
+
+		if errStart != nil || errStop != nil || (!all && !stop.After(now)) {
+			continue
+		}
+
+		var listing = structs.XtreamEPGListing{
+			ID:             structs.XtreamValue(strconv.Itoa(i + 1)),
+			EPGID:          "0",
+			Start:          start.Local().Format("2006-01-02 15:04:05"),
+			End:            stop.Local().Format("2006-01-02 15:04:05"),
+			ChannelID:      structs.XtreamValue(channel.XChannelID),
+			StartTimestamp: structs.XtreamValue(strconv.FormatInt(start.Unix(), 10)),
+			StopTimestamp:  structs.XtreamValue(strconv.FormatInt(stop.Unix(), 10)),
+		}
+
+		if len(program.Title) > 0 {
+			listing.Title = base64.StdEncoding.EncodeToString([]byte(program.Title[0].Value))
+			listing.Lang = program.Title[0].Lang
+		}
+
+		if len(program.Desc) > 0 {
+			listing.Description = base64.StdEncoding.EncodeToString([]byte(program.Desc[0].Value))
+		}
+
+		if all {
+
+			listing.NowPlaying = "0"
+			listing.HasArchive = "0"
+
+			if !start.After(now) && stop.After(now) {
+				listing.NowPlaying = "1"
+			}
+
+		}
+
+		listings = append(listings, listing)
+
+		if !all && len(listings) >= limit {
+			break
+		}
+
+	}
+
+	return
+}
+
+// GetPlaylist : get.php, M3U playlist with the /live/ URLs of the credentials. output: ts, m3u8 (hls)
+func GetPlaylist(username, password, output string) []byte {
+
+	if output == "hls" {
+		output = "m3u8"
+	}
+
+	if output != "m3u8" {
+		output = "ts"
+	}
+
+	var streams, _ = Lineup()
+	var server = ServerURL()
+	var credentials = url.Values{"username": {username}, "password": {password}}.Encode()
+	var quotes = strings.NewReplacer(`"`, "", "\n", " ", "\r", "")
+	var m3u strings.Builder
+
+	fmt.Fprintf(&m3u, "#EXTM3U url-tvg=\"%s/xmltv.php?%s\"\n", server, credentials)
+
+	for _, stream := range streams {
+
+		var channel = stream.Channel
+
+		fmt.Fprintf(&m3u, "#EXTINF:-1 tvg-id=\"%s\" tvg-name=\"%s\" tvg-logo=\"%s\" group-title=\"%s\",%s\n", channel.XChannelID, quotes.Replace(channel.XName), logo(channel.TvgLogo), quotes.Replace(stream.Group), quotes.Replace(channel.XName))
+		fmt.Fprintf(&m3u, "%s/live/%s/%s/%d.%s\n", server, url.PathEscape(username), url.PathEscape(password), stream.StreamID, output)
+
+	}
+
+	return []byte(m3u.String())
+}
+
+// ServerURL : Address of Threadfin for the clients, the same as in the M3U file
+func ServerURL() string {
+
+	if config.Settings.ForceHttps && len(config.Settings.HttpsThreadfinDomain) > 0 {
+		return "https://" + config.Settings.HttpsThreadfinDomain
+	}
+
+	return serverProtocol() + "://" + config.System.Domain
+}
+
+func serverProtocol() string {
+
+	if config.Settings.ForceHttps && len(config.Settings.HttpsThreadfinDomain) > 0 {
+		return "https"
+	}
+
+	if len(config.System.ServerProtocol.M3U) > 0 {
+		return config.System.ServerProtocol.M3U
+	}
+
+	return "http"
+}
+
+// serverHost : Host and port of the server address
+func serverHost() (host, port string) {
+
+	var address = strings.TrimPrefix(strings.TrimPrefix(ServerURL(), "https://"), "http://")
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+
+		host = address
+		port = config.Settings.Port
+
+		if serverProtocol() == "https" {
+			port = strconv.Itoa(config.Settings.HttpsPort)
+		}
+
+	}
+
+	return
+}
+
+// logo : Channel logo of the image cache
+func logo(src string) string {
+
+	if imgc := config.Data.Cache.Images; imgc != nil && len(src) > 0 {
+		return imgc.Image.GetURL(src, config.Settings.HttpThreadfinDomain, config.Settings.Port, config.Settings.ForceHttps, config.Settings.HttpsPort, config.Settings.HttpsThreadfinDomain)
+	}
+
+	return src
+}
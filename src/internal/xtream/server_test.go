@@ -0,0 +1,231 @@
+package xtream
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/m3u-parser"
+	"threadfin/internal/structs"
+	"time"
+)
+
+func setLineup(t *testing.T) {
+
+	config.System.Domain = "threadfin:34400"
+	config.Data.XEPG.Channels = map[string]any{
+		"x-ID.7":  structs.XEPGChannelStruct{XName: "ZDF", XChannelID: "2", XGroupTitle: "News", XActive: true, FileM3UID: "M1", URL: "http://1/zdf", XmltvFile: "Threadfin Dummy", XMapping: "60_Minutes"},
+		"x-ID.3":  structs.XEPGChannelStruct{XName: "Das Erste", XChannelID: "1", XGroupTitle: "News", XActive: true, FileM3UID: "M1", URL: "http://1/ard"},
+		"x-ID.12": structs.XEPGChannelStruct{XName: "Sport \"1\"", XChannelID: "10", XGroupTitle: "Sport", XCategory: "Sports", XActive: true, FileM3UID: "M1", URL: "http://1/sport"},
+		"x-ID.4":  structs.XEPGChannelStruct{XName: "Hidden", XChannelID: "3", XGroupTitle: "News", XActive: true, XHideChannel: true, FileM3UID: "M1", URL: "http://1/hidden"},
+		"x-ID.5":  structs.XEPGChannelStruct{XName: "Inactive", XChannelID: "4", XGroupTitle: "Movies", FileM3UID: "M1", URL: "http://1/inactive"},
+	}
+
+	t.Cleanup(func() {
+		config.System.Domain = ""
+		config.Data.XEPG.Channels = nil
+	})
+
+}
+
+func TestLineup(t *testing.T) {
+
+	setLineup(t)
+
+	streams, categories := Lineup()
+
+	if len(categories) != 2 || categories[0].CategoryName != "News" || categories[0].CategoryID != "1" || categories[1].CategoryName != "Sports" || categories[1].CategoryID != "2" {
+		t.Fatalf("unexpected categories: %+v", categories)
+	}
+
+	var expected = []struct {
+		streamID   int
+		categoryID string
+	}{{3, "1"}, {7, "1"}, {12, "2"}}
+
+	if len(streams) != len(expected) {
+		t.Fatalf("expected %d streams, got %d", len(expected), len(streams))
+	}
+
+	for i, stream := range streams {
+
+		if stream.StreamID != expected[i].streamID || stream.CategoryID != expected[i].categoryID {
+			t.Errorf("stream %d: unexpected stream %d (category %s)", i, stream.StreamID, stream.CategoryID)
+		}
+
+	}
+
+	if _, ok := FindStream(4); ok {
+		t.Error("hidden channel found")
+	}
+
+}
+
+func TestPlayerAPI(t *testing.T) {
+
+	setLineup(t)
+
+	response, err := PlayerAPI(url.Values{"username": {"user"}, "password": {"pass"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var account = response.(structs.XtreamServerAccount)
+	if account.UserInfo.Auth != 1 || account.UserInfo.Username != "user" || account.ServerInfo.URL != "threadfin" || account.ServerInfo.Port != "34400" || account.ServerInfo.ServerProtocol != "http" {
+		t.Errorf("unexpected account: %+v", account)
+	}
+
+	response, err = PlayerAPI(url.Values{"action": {"get_live_streams"}, "category_id": {"2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var streams = response.([]structs.XtreamServerStream)
+	if len(streams) != 1 || streams[0].StreamID != 12 || streams[0].Num != 3 || streams[0].EPGChannelID != "10" || streams[0].CategoryID != "2" {
+		t.Errorf("unexpected streams: %+v", streams)
+	}
+
+	response, err = PlayerAPI(url.Values{"action": {"get_vod_streams"}})
+	if err != nil || len(response.([]any)) != 0 {
+		t.Errorf("unexpected VOD streams: %v %v", response, err)
+	}
+
+	if _, err = PlayerAPI(url.Values{"action": {"get_short_epg"}, "stream_id": {"x"}}); err == nil {
+		t.Error("invalid stream_id accepted")
+	}
+
+}
+
+func TestEPGListings(t *testing.T) {
+
+	setLineup(t)
+
+	response, err := PlayerAPI(url.Values{"action": {"get_short_epg"}, "stream_id": {"7"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var listings = response.(map[string][]structs.XtreamEPGListing)["epg_listings"]
+	if len(listings) != shortEPGLimit {
+		t.Fatalf("expected %d programs, got %d", shortEPGLimit, len(listings))
+	}
+
+	var now = int(time.Now().Unix())
+	if listings[0].StartTimestamp.Int() > now || listings[0].StopTimestamp.Int() <= now || listings[0].ChannelID != "2" {
+		t.Errorf("current program expected: %+v", listings[0])
+	}
+
+	if title, _ := base64.StdEncoding.DecodeString(listings[0].Title); !strings.Contains(string(title), "ZDF") {
+		t.Errorf("unexpected title %q", title)
+	}
+
+	listings = EPGListings(streamChannel(t, 7), 0, true)
+
+	var playing int
+	for _, listing := range listings {
+
+		if listing.NowPlaying == "1" {
+			playing++
+		}
+
+	}
+
+	if len(listings) <= shortEPGLimit || playing != 1 {
+		t.Errorf("unexpected data table: %d programs, %d playing", len(listings), playing)
+	}
+
+}
+
+func TestGetPlaylist(t *testing.T) {
+
+	setLineup(t)
+
+	var playlist = GetPlaylist("user", "pa ss", "hls")
+
+	if !strings.HasPrefix(string(playlist), `#EXTM3U url-tvg="http://threadfin:34400/xmltv.php?password=pa+ss&username=user"`) {
+		t.Errorf("unexpected header:\n%s", playlist)
+	}
+
+	channels, err := m3u.MakeInterfaceFromM3U(playlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(channels) != 3 {
+		t.Fatalf("expected 3 channels, got %d", len(channels))
+	}
+
+	var sport = channels[2].(map[string]string)
+	if sport["name"] != "Sport 1" || sport["tvg-id"] != "10" || sport["group-title"] != "Sports" || sport["url"] != "http://threadfin:34400/live/user/pa%20ss/12.m3u8" {
+		t.Errorf("unexpected channel: %v", sport)
+	}
+
+}
+
+func streamChannel(t *testing.T, streamID int) structs.XEPGChannelStruct {
+
+	stream, ok := FindStream(streamID)
+	if !ok {
+		t.Fatalf("stream %d not found", streamID)
+	}
+
+	return stream.Channel
+}
+
+func TestLineupScan(t *testing.T) {
+
+	setLineup(t)
+	Lineup()
+
+	// The XEPG build in the background changes the channels, the last lineup is used
+	config.System.ScanInProgress = 1
+	config.Data.XEPG.Channels = map[string]any{}
+	defer func() { config.System.ScanInProgress = 0 }()
+
+	if streams, categories := Lineup(); len(streams) != 3 || len(categories) != 2 {
+		t.Errorf("last lineup expected during the XEPG build: %d streams, %d categories", len(streams), len(categories))
+	}
+
+	config.System.ScanInProgress = 0
+
+	if streams, _ := Lineup(); len(streams) != 0 {
+		t.Errorf("expected the new lineup after the XEPG build, got %d streams", len(streams))
+	}
+
+}
+
+func TestFindStreamScan(t *testing.T) {
+
+	setLineup(t)
+	Lineup()
+
+	config.System.ScanInProgress = 1
+	defer func() { config.System.ScanInProgress = 0 }()
+
+	// The XEPG build in the background changes the channels without config.XepgMutex (go test -race)
+	var done = make(chan bool)
+	go func() {
+
+		for i := 0; i < 1000; i++ {
+			config.Data.XEPG.Channels["x-ID."+strconv.Itoa(100+i)] = structs.XEPGChannelStruct{XName: "New", XActive: true}
+			delete(config.Data.XEPG.Channels, "x-ID.7")
+		}
+
+		close(done)
+	}()
+
+	for i := 0; i < 1000; i++ {
+		if stream, ok := FindStream(7); !ok || stream.Channel.XName != "ZDF" {
+			t.Fatalf("stream of the last lineup expected: %+v", stream)
+		}
+	}
+
+	<-done
+
+	if _, ok := FindStream(100); ok {
+		t.Error("channel of the running XEPG build found")
+	}
+
+}
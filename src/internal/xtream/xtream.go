@@ -0,0 +1,461 @@
+package xtream
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"threadfin/internal/cli"
+	"threadfin/internal/config"
+	"threadfin/internal/http"
+	"threadfin/internal/structs"
+	"time"
+)
+
+// SourceType : Provider parameter source.type of the M3U and XMLTV providers of an Xtream Codes panel
+const SourceType = "xtream"
+
+// timeLayout : Start and stop of the XMLTV programs
+const timeLayout = "20060102150405 -0700"
+
+// Requests of the short EPG: parallel requests to the panel and the max. time for all channels
+const (
+	shortEPGWorkers = 4
+	shortEPGTimeout = 10 * time.Minute
+)
+
+// Client : Xtream Codes panel (player_api.php, xmltv.php)
+type Client struct {
+	URL      string // Server of the panel, e.g. http://panel.example:8080
+	Username string
+	Password string
+	Output   string // Container of the live streams: ts, m3u8
+	Proxy    string
+}
+
+// NewClient : Client of the provider data (file.source, xtream.username, xtream.password, xtream.output).
+// Links to get.php or player_api.php are accepted, the credentials are taken from the link if they are not set.
+func NewClient(data map[string]any, proxy string) (client Client) {
+
+	client.Proxy = proxy
+	client.URL, _ = data["file.source"].(string)
+	client.Username, _ = data["xtream.username"].(string)
+	client.Password, _ = data["xtream.password"].(string)
+	client.Output, _ = data["xtream.output"].(string)
+
+	if u, err := url.Parse(strings.TrimSpace(client.URL)); err == nil && len(u.Host) > 0 {
+
+		if len(client.Username) == 0 {
+			client.Username = u.Query().Get("username")
+		}
+
+		if len(client.Password) == 0 {
+			client.Password = u.Query().Get("password")
+		}
+
+		if strings.HasSuffix(u.Path, ".php") {
+			u.Path = path.Dir(u.Path)
+		}
+
+		u.RawQuery = ""
+		client.URL = u.String()
+
+	}
+
+	client.URL = strings.TrimRight(client.URL, "/")
+
+	if client.Output != "m3u8" {
+		client.Output = "ts"
+	}
+
+	return
+}
+
+// Download : Playlist (m3u) or EPG (xmltv) of the panel for the local provider file
+func Download(fileType string, client Client) (filename string, body []byte, err error) {
+
+	account, err := client.Authenticate()
+	if err != nil {
+		return
+	}
+
+	filename = strings.TrimPrefix(strings.TrimPrefix(client.URL, "http://"), "https://")
+	cli.ShowInfo(fmt.Sprintf("Xtream:%s | Status: %s | Connections: %s / %s", filename, account.UserInfo.Status, account.UserInfo.ActiveConnections, account.UserInfo.MaxConnections))
+
+	switch fileType {
+
+	case "m3u":
+		var channels []any
+		channels, err = client.Channels()
+		if err == nil {
+			body = Playlist(channels)
+		}
+
+	case "xmltv":
+		body, err = client.Guide()
+
+	default:
+		err = fmt.Errorf("xtream: unsupported file type %s", fileType)
+
+	}
+
+	return
+}
+
+// Authenticate : Account of the credentials, error if the panel rejects them or the account is not active
+func (client Client) Authenticate() (account structs.XtreamAccount, err error) {
+
+	err = client.api("", nil, &account)
+	if err != nil {
+		return
+	}
+
+	if account.UserInfo.Auth.Int() != 1 {
+		err = fmt.Errorf("xtream: authentication failed (%s)", client.URL)
+		return
+	}
+
+	if status := account.UserInfo.Status; len(status) > 0 && !strings.EqualFold(status, "Active") {
+		err = fmt.Errorf("xtream: account is not active (%s)", status)
+	}
+
+	return
+}
+
+// LiveCategories : Categories of the live streams
+func (client Client) LiveCategories() (categories []structs.XtreamCategory, err error) {
+	err = client.api("get_live_categories", nil, &categories)
+	return
+}
+
+// LiveStreams : All live streams of the account
+func (client Client) LiveStreams() (streams []structs.XtreamStream, err error) {
+	err = client.api("get_live_streams", nil, &streams)
+	return
+}
+
+// ShortEPG : Next programs of a live stream, limit 0 uses the limit of the panel
+func (client Client) ShortEPG(streamID string, limit int) (listings []structs.XtreamEPGListing, err error) {
+
+	var parameters = url.Values{"stream_id": {streamID}}
+	if limit > 0 {
+		parameters.Set("limit", fmt.Sprint(limit))
+	}
+
+	var response struct {
+		Listings []structs.XtreamEPGListing `json:"epg_listings"`
+	}
+
+	err = client.api("get_short_epg", parameters, &response)
+	listings = response.Listings
+
+	return
+}
+
+// XMLTV : xmltv.php of the panel
+func (client Client) XMLTV() (body []byte, err error) {
+	_, body, err = http.DownloadFile(client.URL+"/xmltv.php?"+client.credentials().Encode(), client.Proxy)
+	return
+}
+
+// StreamURL : URL of a live stream
+func (client Client) StreamURL(streamID string) string {
+	return fmt.Sprintf("%s/live/%s/%s/%s.%s", client.URL, url.PathEscape(client.Username), url.PathEscape(client.Password), streamID, client.Output)
+}
+
+// Channels : Live streams of the panel as channel maps of the M3U parser.
+// Besides the tvg attributes the category and the catch-up of the panel are kept (xtream-category-id, catchup, catchup-days).
+func (client Client) Channels() (channels []any, err error) {
+
+	categories, err := client.LiveCategories()
+	if err != nil {
+		return
+	}
+
+	streams, err := client.LiveStreams()
+	if err != nil {
+		return
+	}
+
+	var groups = make(map[structs.XtreamValue]string, len(categories))
+	for _, category := range categories {
+		groups[category.CategoryID] = category.CategoryName
+	}
+
+	for _, stream := range streams {
+
+		if len(stream.StreamID) == 0 || len(stream.Name) == 0 {
+			continue
+		}
+
+		var channel = map[string]string{
+			"name":               stream.Name,
+			"tvg-id":             string(stream.EPGChannelID),
+			"tvg-name":           stream.Name,
+			"tvg-logo":           stream.StreamIcon,
+			"group-title":        groups[stream.CategoryID],
+			"xtream-stream-id":   string(stream.StreamID),
+			"xtream-category-id": string(stream.CategoryID),
+			"url":                client.StreamURL(string(stream.StreamID)),
+		}
+
+		if stream.TVArchive.Int() == 1 {
+			channel["catchup"] = "xc"
+			channel["catchup-days"] = string(stream.TVArchiveDuration)
+		}
+
+		channels = append(channels, channel)
+
+	}
+
+	if len(channels) == 0 {
+		err = errors.New("xtream: no live streams")
+	}
+
+	return
+}
+
+// Playlist : M3U file of the channel maps
+func Playlist(channels []any) []byte {
+
+	var attributes = []string{"tvg-id", "tvg-name", "tvg-logo", "group-title", "xtream-stream-id", "xtream-category-id", "catchup", "catchup-days"}
+	var quotes = strings.NewReplacer(`"`, "", "\n", " ", "\r", "")
+	var playlist strings.Builder
+
+	playlist.WriteString("#EXTM3U\n")
+
+	for _, c := range channels {
+
+		var channel = c.(map[string]string)
+
+		playlist.WriteString("#EXTINF:-1")
+
+		for _, attribute := range attributes {
+
+			if value, ok := channel[attribute]; ok {
+				fmt.Fprintf(&playlist, ` %s="%s"`, attribute, quotes.Replace(value))
+			}
+
+		}
+
+		fmt.Fprintf(&playlist, ",%s\n%s\n", quotes.Replace(channel["name"]), channel["url"])
+
+	}
+
+	return []byte(playlist.String())
+}
+
+// Guide : xmltv.php of the panel. If the panel has no XMLTV file, the EPG is created from the short EPG of the active channels.
+func (client Client) Guide() (body []byte, err error) {
+
+	body, err = client.XMLTV()
+	if err == nil {
+
+		var xmltv structs.XMLTV
+		if xml.Unmarshal(body, &xmltv) == nil && len(xmltv.Program) > 0 {
+			return
+		}
+
+		err = errors.New("no programs")
+
+	}
+
+	cli.ShowInfo("Xtream:xmltv.php (" + err.Error() + "), short EPG of the live streams is used")
+
+	return client.shortGuide()
+}
+
+// shortGuide : XMLTV file of the short EPG, one channel per EPG channel ID.
+// The programs are only requested for the EPG channels of active XEPG channels (x-mapping, tvg-id), all channels are listed for the mapping.
+func (client Client) shortGuide() (body []byte, err error) {
+
+	streams, err := client.LiveStreams()
+	if err != nil {
+		return
+	}
+
+	var xmltv = structs.XMLTV{Generator: config.System.Name, Source: client.URL}
+	var channels = make(map[structs.XtreamValue]bool)
+	var active = activeEPGChannels()
+	var requests []structs.XtreamStream
+
+	for _, stream := range streams {
+
+		if len(stream.EPGChannelID) == 0 || channels[stream.EPGChannelID] {
+			continue
+		}
+
+		channels[stream.EPGChannelID] = true
+
+		xmltv.Channel = append(xmltv.Channel, &structs.Channel{
+			ID:          string(stream.EPGChannelID),
+			DisplayName: []structs.DisplayName{{Value: stream.Name}},
+			Icon:        structs.Icon{Src: stream.StreamIcon},
+		})
+
+		if active[string(stream.EPGChannelID)] {
+			requests = append(requests, stream)
+		}
+
+	}
+
+	if len(xmltv.Channel) == 0 {
+		err = errors.New("xtream: no EPG channels")
+		return
+	}
+
+	cli.ShowInfo(fmt.Sprintf("Xtream:Short EPG | Channels: %d / %d", len(requests), len(xmltv.Channel)))
+
+	for _, listings := range client.shortEPGs(requests) {
+
+		for _, listing := range listings {
+
+			var start, stop = listing.StartTimestamp.Int(), listing.StopTimestamp.Int()
+			if start == 0 || stop == 0 {
+				continue
+			}
+
+			var program = &structs.Program{
+				Channel: string(listing.ChannelID),
+				Start:   time.Unix(int64(start), 0).UTC().Format(timeLayout),
+				Stop:    time.Unix(int64(stop), 0).UTC().Format(timeLayout),
+				Title:   []*structs.Title{{Lang: listing.Lang, Value: decode(listing.Title)}},
+			}
+
+			if description := decode(listing.Description); len(description) > 0 {
+				program.Desc = []*structs.Desc{{Lang: listing.Lang, Value: description}}
+			}
+
+			xmltv.Program = append(xmltv.Program, program)
+
+		}
+
+	}
+
+	body, err = xml.MarshalIndent(xmltv, "", "  ")
+	if err == nil {
+		body = append([]byte(xml.Header), body...)
+	}
+
+	return
+}
+
+// shortEPGs : Short EPG of the streams in the order of the streams, channel_id of the listings is the EPG channel ID of the stream.
+// The requests are sent by shortEPGWorkers in parallel, the streams that are not requested within shortEPGTimeout are skipped.
+func (client Client) shortEPGs(streams []structs.XtreamStream) (results [][]structs.XtreamEPGListing) {
+
+	var queue = make(chan int)
+	var deadline = time.Now().Add(shortEPGTimeout)
+	var wg sync.WaitGroup
+
+	results = make([][]structs.XtreamEPGListing, len(streams))
+
+	for w := 0; w < min(shortEPGWorkers, len(streams)); w++ {
+
+		wg.Add(1)
+
+		go func() {
+
+			defer wg.Done()
+
+			for i := range queue {
+
+				listings, err := client.ShortEPG(string(streams[i].StreamID), 0)
+				if err != nil {
+					cli.ShowError(err, 0)
+					continue
+				}
+
+				for j := range listings {
+					listings[j].ChannelID = streams[i].EPGChannelID
+				}
+
+				results[i] = listings
+
+			}
+
+		}()
+
+	}
+
+	for i := range streams {
+
+		if time.Now().After(deadline) {
+			cli.ShowInfo(fmt.Sprintf("Xtream:Short EPG | Time limit reached, %d channels skipped", len(streams)-i))
+			break
+		}
+
+		queue <- i
+
+	}
+
+	close(queue)
+	wg.Wait()
+
+	return
+}
+
+// activeEPGChannels : EPG channel IDs of the channels of the lineup (x-mapping and tvg-id)
+func activeEPGChannels() (ids map[string]bool) {
+
+	ids = make(map[string]bool)
+
+	var streams, _ = Lineup()
+
+	for _, stream := range streams {
+
+		var channel = stream.Channel
+
+		for _, id := range []string{channel.XMapping, channel.TvgID} {
+			if len(id) > 0 && id != "-" {
+				ids[id] = true
+			}
+		}
+
+	}
+
+	return
+}
+
+// api : Request to player_api.php, action "" returns the account
+func (client Client) api(action string, parameters url.Values, v any) (err error) {
+
+	var query = client.credentials()
+	if len(action) > 0 {
+		query.Set("action", action)
+	}
+
+	for key, values := range parameters {
+		query[key] = values
+	}
+
+	_, body, err := http.DownloadFile(client.URL+"/player_api.php?"+query.Encode(), client.Proxy)
+	if err != nil {
+		return
+	}
+
+	if err = json.Unmarshal(body, v); err != nil {
+		err = fmt.Errorf("xtream: %s: %w", action, err)
+	}
+
+	return
+}
+
+func (client Client) credentials() url.Values {
+	return url.Values{"username": {client.Username}, "password": {client.Password}}
+}
+
+// decode : Title and description of the short EPG are base64 encoded
+func decode(value string) string {
+
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil {
+		return string(decoded)
+	}
+
+	return value
+}
@@ -0,0 +1,189 @@
+package xtream
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"threadfin/internal/config"
+	"threadfin/internal/m3u-parser"
+	"threadfin/internal/structs"
+)
+
+// fakePanel : player_api.php and xmltv.php of an Xtream Codes panel, the IDs are sent as numbers and strings like the real panels do
+func fakePanel(t *testing.T, xmltv string) *httptest.Server {
+
+	var mux = http.NewServeMux()
+
+	mux.HandleFunc("/player_api.php", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Query().Get("username") != "user" || r.URL.Query().Get("password") != "pass" {
+			fmt.Fprint(w, `{"user_info":{"auth":0}}`)
+			return
+		}
+
+		switch r.URL.Query().Get("action") {
+
+		case "":
+			fmt.Fprint(w, `{"user_info":{"username":"user","auth":1,"status":"Active","active_cons":"0","max_connections":"2"},"server_info":{"url":"panel","port":"8080"}}`)
+
+		case "get_live_categories":
+			fmt.Fprint(w, `[{"category_id":"1","category_name":"News","parent_id":0},{"category_id":2,"category_name":"Sports","parent_id":0}]`)
+
+		case "get_live_streams":
+			fmt.Fprint(w, `[
+				{"num":1,"name":"News 24","stream_type":"live","stream_id":101,"stream_icon":"http://logo/101.png","epg_channel_id":"news24.de","category_id":"1","tv_archive":1,"tv_archive_duration":"7"},
+				{"num":2,"name":"Sport \"Live\"","stream_type":"live","stream_id":"102","stream_icon":"","epg_channel_id":null,"category_id":2,"tv_archive":0,"tv_archive_duration":0}
+			]`)
+
+		case "get_short_epg":
+			if r.URL.Query().Get("stream_id") != "101" {
+				t.Errorf("short EPG of stream %s", r.URL.Query().Get("stream_id"))
+			}
+			// Title: "Morning News", description: "Headlines"
+			fmt.Fprint(w, `{"epg_listings":[{"id":"1","title":"TW9ybmluZyBOZXdz","lang":"de","description":"SGVhZGxpbmVz","start_timestamp":"1700000000","stop_timestamp":1700003600}]}`)
+
+		}
+
+	})
+
+	mux.HandleFunc("/xmltv.php", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, xmltv)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestNewClient(t *testing.T) {
+
+	var client = NewClient(map[string]any{"file.source": "http://panel:8080/get.php?username=user&password=pass&type=m3u_plus"}, "")
+
+	if client.URL != "http://panel:8080" || client.Username != "user" || client.Password != "pass" || client.Output != "ts" {
+		t.Errorf("unexpected client: %+v", client)
+	}
+
+	client = NewClient(map[string]any{"file.source": "http://panel:8080/", "xtream.username": "other", "xtream.password": "secret", "xtream.output": "m3u8"}, "")
+
+	if client.URL != "http://panel:8080" || client.Username != "other" || client.Password != "secret" || client.Output != "m3u8" {
+		t.Errorf("unexpected client: %+v", client)
+	}
+
+}
+
+func TestDownloadPlaylist(t *testing.T) {
+
+	var panel = fakePanel(t, "")
+	defer panel.Close()
+
+	var client = NewClient(map[string]any{"file.source": panel.URL, "xtream.username": "user", "xtream.password": "pass"}, "")
+
+	_, body, err := Download("m3u", client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The local file is read by the M3U parser like every other playlist
+	channels, err := m3u.MakeInterfaceFromM3U(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(channels) != 2 {
+		t.Fatalf("expected 2 channels, got %d:\n%s", len(channels), body)
+	}
+
+	var news = channels[0].(map[string]string)
+	var expected = map[string]string{
+		"name":               "News 24",
+		"tvg-id":             "news24.de",
+		"tvg-logo":           "http://logo/101.png",
+		"group-title":        "News",
+		"xtream-stream-id":   "101",
+		"xtream-category-id": "1",
+		"catchup":            "xc",
+		"catchup-days":       "7",
+		"url":                panel.URL + "/live/user/pass/101.ts",
+	}
+
+	for key, value := range expected {
+		if news[key] != value {
+			t.Errorf("%s: expected %q, got %q", key, value, news[key])
+		}
+	}
+
+	var sport = channels[1].(map[string]string)
+	if sport["group-title"] != "Sports" || sport["name"] != "Sport Live" || len(sport["catchup"]) > 0 {
+		t.Errorf("unexpected channel: %v", sport)
+	}
+
+	client.Password = "wrong"
+	if _, _, err = Download("m3u", client); err == nil {
+		t.Error("wrong credentials accepted")
+	}
+
+}
+
+func TestDownloadGuide(t *testing.T) {
+
+	var guide = `<?xml version="1.0" encoding="UTF-8"?><tv><channel id="news24.de"><display-name>News 24</display-name></channel><programme channel="news24.de" start="20231114220000 +0000" stop="20231114230000 +0000"><title>Night News</title></programme></tv>`
+
+	var panel = fakePanel(t, guide)
+	var client = NewClient(map[string]any{"file.source": panel.URL, "xtream.username": "user", "xtream.password": "pass"}, "")
+
+	_, body, err := Download("xmltv", client)
+	if err != nil || string(body) != guide {
+		t.Errorf("xmltv.php not used: %v\n%s", err, body)
+	}
+
+	panel.Close()
+
+	// Without xmltv.php the short EPG is used
+	panel = fakePanel(t, "")
+	defer panel.Close()
+
+	client = NewClient(map[string]any{"file.source": panel.URL, "xtream.username": "user", "xtream.password": "pass"}, "")
+
+	// No active XEPG channel: the channels are listed for the mapping, the programs are not requested
+	_, body, err = Download("xmltv", client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var xmltv structs.XMLTV
+	if err = xml.Unmarshal(body, &xmltv); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(xmltv.Channel) != 1 || len(xmltv.Program) != 0 {
+		t.Fatalf("unexpected EPG without active channels:\n%s", body)
+	}
+
+	config.Data.XEPG.Channels = map[string]any{
+		"x-ID.1": map[string]any{"x-active": true, "x-mapping": "news24.de"},
+		"x-ID.2": map[string]any{"x-active": false, "x-mapping": "sport.de"},
+	}
+	defer func() {
+		config.Data.XEPG.Channels = nil
+	}()
+
+	_, body, err = Download("xmltv", client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xmltv = structs.XMLTV{}
+	if err = xml.Unmarshal(body, &xmltv); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(xmltv.Channel) != 1 || len(xmltv.Program) != 1 {
+		t.Fatalf("unexpected EPG:\n%s", body)
+	}
+
+	var program = xmltv.Program[0]
+	if program.Channel != "news24.de" || program.Start != "20231114221320 +0000" || program.Stop != "20231114231320 +0000" || program.Title[0].Value != "Morning News" || program.Desc[0].Value != "Headlines" {
+		t.Errorf("unexpected program: %+v", program)
+	}
+
+}